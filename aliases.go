@@ -0,0 +1,151 @@
+package webcolors
+
+import "strings"
+
+// # Color values defined under several names.
+// #################################################################
+//
+// Some values have several names in a specification, such as #00ffff,
+// both aqua and cyan, or #808080, both gray and grey. Reverse lookups
+// return the name the specification prefers, which a NamePreference
+// can override for a single call with HexToNameWith and its variants.
+
+// NamePreference a preference for one of the spellings of a name defined under two,
+// such as PreferGrey, which selects darkslategrey over darkslategray
+type NamePreference string
+
+const (
+	// PreferGray the gray spellings, such as gray and darkslategray
+	PreferGray NamePreference = "gray"
+	// PreferGrey the grey spellings, such as grey and darkslategrey
+	PreferGrey NamePreference = "grey"
+	// PreferAqua aqua over cyan
+	PreferAqua NamePreference = "aqua"
+	// PreferCyan cyan over aqua
+	PreferCyan NamePreference = "cyan"
+	// PreferFuchsia fuchsia over magenta
+	PreferFuchsia NamePreference = "fuchsia"
+	// PreferMagenta magenta over fuchsia
+	PreferMagenta NamePreference = "magenta"
+)
+
+// nameSubstitutions the spelling each NamePreference replaces, and the spelling it replaces it with
+var nameSubstitutions = map[NamePreference][2]string{
+	PreferGray:    {"grey", "gray"},
+	PreferGrey:    {"gray", "grey"},
+	PreferAqua:    {"cyan", "aqua"},
+	PreferCyan:    {"aqua", "cyan"},
+	PreferFuchsia: {"magenta", "fuchsia"},
+	PreferMagenta: {"fuchsia", "magenta"},
+}
+
+// HexToNames Convert a hexadecimal color value to every normalized color name defined for it.
+//
+// Names are in canonical order: the one the specification prefers first, then
+// the others in alphabetical order, except that the first name selected by one
+// of the given preferences, tried in order, comes first. Preferences other than
+// the NamePreference constants are an ErrInvalidValue error.
+func HexToNames(hexValue string, spec string, prefs ...NamePreference) ([]string, error) {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return nil, unsupportedSpecError(spec)
+	}
+	normalized, err := ParseHex(hexValue)
+	if err != nil {
+		return nil, err
+	}
+	names, ok := registered.hexToNames[normalized]
+	if !ok {
+		return nil, &ColorError{Err: ErrNoNameForValue, Value: hexValue, Spec: spec}
+	}
+	return preferNames(names, prefs)
+}
+
+// preferNames Internal helper returning a copy of the names with the first one
+// selected by the preferences moved to the front. A preference selects a name
+// when another of the names spells into it by the substitution of the preference,
+// such as darkslategrey from darkslategray for PreferGrey.
+func preferNames(names []string, prefs []NamePreference) ([]string, error) {
+	for _, pref := range prefs {
+		if _, ok := nameSubstitutions[pref]; !ok {
+			return nil, invalidValueError(string(pref), "a supported name preference")
+		}
+	}
+	for _, pref := range prefs {
+		substitution := nameSubstitutions[pref]
+		for _, name := range names {
+			spelled := strings.Replace(name, substitution[0], substitution[1], 1)
+			if spelled == name {
+				continue
+			}
+			for i, other := range names {
+				if other == spelled {
+					preferred := append([]string{other}, names[:i]...)
+					return append(preferred, names[i+1:]...), nil
+				}
+			}
+		}
+	}
+	return append([]string{}, names...), nil
+}
+
+// HexToNameWith Convert a hexadecimal color value to its corresponding normalized color name,
+// if any such name exists, as HexToName does, except that the first name selected by one of
+// the given preferences, tried in order, is returned instead of the one the specification prefers
+func HexToNameWith(hexValue string, spec string, prefs ...NamePreference) (string, error) {
+	names, err := HexToNames(hexValue, spec, prefs...)
+	if err != nil {
+		return "", err
+	}
+	return names[0], nil
+}
+
+// RGBToNames Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to every normalized color name defined for it
+func RGBToNames(rgbTriplet []int, spec string, prefs ...NamePreference) ([]string, error) {
+	if err := checkTriplet(len(rgbTriplet)); err != nil {
+		return nil, err
+	}
+	return HexToNames(RGBToHex(rgbTriplet), spec, prefs...)
+}
+
+// RGBToNameWith Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to its
+// corresponding normalized color name, if any such name exists, with preferences as HexToNameWith
+func RGBToNameWith(rgbTriplet []int, spec string, prefs ...NamePreference) (string, error) {
+	if err := checkTriplet(len(rgbTriplet)); err != nil {
+		return "", err
+	}
+	return HexToNameWith(RGBToHex(NormalizeIntegerTriplet(rgbTriplet)), spec, prefs...)
+}
+
+// RGBPercentToNames Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to every normalized color name defined for it
+func RGBPercentToNames(rgbPercentTriplet []string, spec string, prefs ...NamePreference) ([]string, error) {
+	if err := checkTriplet(len(rgbPercentTriplet)); err != nil {
+		return nil, err
+	}
+	npt, err := NormalizePercentTriplet(rgbPercentTriplet)
+	if err != nil {
+		return nil, err
+	}
+	rgb, err := RGBPercentToRGB(npt)
+	if err != nil {
+		return nil, err
+	}
+	return RGBToNames(rgb, spec, prefs...)
+}
+
+// RGBPercentToNameWith Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to
+// its corresponding normalized color name, if any such name exists, with preferences as HexToNameWith
+func RGBPercentToNameWith(rgbPercentTriplet []string, spec string, prefs ...NamePreference) (string, error) {
+	if err := checkTriplet(len(rgbPercentTriplet)); err != nil {
+		return "", err
+	}
+	npt, err := NormalizePercentTriplet(rgbPercentTriplet)
+	if err != nil {
+		return "", err
+	}
+	rgb, err := RGBPercentToRGB(npt)
+	if err != nil {
+		return "", err
+	}
+	return RGBToNameWith(rgb, spec, prefs...)
+}
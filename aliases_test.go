@@ -0,0 +1,109 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestHexToNames(t *testing.T) {
+	for _, c := range []struct {
+		hexValue string
+		spec     string
+		expected []string
+	}{
+		{"#00FFFF", CSS3, []string{"aqua", "cyan"}},
+		{"#f0f", CSS4, []string{"fuchsia", "magenta"}},
+		{"#808080", CSS3, []string{"gray", "grey"}},
+		{"#808080", HTML4, []string{"gray", "grey"}},
+		{"#2f4f4f", CSS4, []string{"darkslategray", "darkslategrey"}},
+		{"#ff0000", CSS3, []string{"red"}},
+	} {
+		names, err := HexToNames(c.hexValue, c.spec)
+		if err != nil || !equalNames(names, c.expected) {
+			t.Error("expected", c.expected, "for", c.hexValue, "got", names, err)
+		}
+	}
+	if _, err := HexToNames("#123456", CSS3); !errors.Is(err, ErrNoNameForValue) {
+		t.Error("expected ErrNoNameForValue, got", err)
+	}
+	if _, err := HexToNames("#00ffff", "css5"); !errors.Is(err, ErrUnsupportedSpec) {
+		t.Error("expected ErrUnsupportedSpec, got", err)
+	}
+	names, _ := HexToNames("#00ffff", CSS3)
+	names[0] = "octarine"
+	if value, _ := HexToName("#00ffff", CSS3); value != "aqua" {
+		t.Error("expected HexToNames to return a copy, got", value)
+	}
+}
+
+func TestNamePreferences(t *testing.T) {
+	names, _ := HexToNames("#00ffff", CSS3, PreferCyan)
+	if !equalNames(names, []string{"cyan", "aqua"}) {
+		t.Error("expected cyan first, got", names)
+	}
+	value, _ := HexToNameWith("#a9a9a9", CSS3, PreferGrey)
+	if value != "darkgrey" {
+		t.Error("expected darkgrey, got", value)
+	}
+	value, _ = HexToNameWith("#ff00ff", CSS3, PreferGrey, PreferMagenta)
+	if value != "magenta" {
+		t.Error("expected magenta, got", value)
+	}
+	value, _ = HexToNameWith("#ff00ff", CSS3, PreferMagenta, PreferFuchsia)
+	if value != "magenta" {
+		t.Error("expected the first matching preference to win, got", value)
+	}
+	value, _ = HexToNameWith("#ff0000", CSS3, PreferGrey)
+	if value != "red" {
+		t.Error("expected red, got", value)
+	}
+	value, _ = RGBToNameWith([]int{128, 128, 128}, CSS4, PreferGrey)
+	if value != "grey" {
+		t.Error("expected grey, got", value)
+	}
+	value, _ = RGBPercentToNameWith([]string{"0%", "100%", "100%"}, CSS3, PreferCyan)
+	if value != "cyan" {
+		t.Error("expected cyan, got", value)
+	}
+	value, _ = HexToNameWith("#00ffff", CSS3, PreferAqua)
+	if value != "aqua" {
+		t.Error("expected aqua, got", value)
+	}
+	value, _ = HexToNameWith("#ff00ff", CSS3, PreferFuchsia, PreferMagenta)
+	if value != "fuchsia" {
+		t.Error("expected an already preferred name to win, got", value)
+	}
+	value, _ = HexToNameWith("#e0ffff", CSS3, PreferAqua)
+	if value != "lightcyan" {
+		t.Error("expected lightcyan, got", value)
+	}
+	if _, err := HexToNameWith("#808080", CSS3, "ey"); !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for an unknown preference, got", err)
+	}
+}
+
+func TestRGBToNames(t *testing.T) {
+	names, _ := RGBToNames([]int{255, 0, 255}, CSS3)
+	if !equalNames(names, []string{"fuchsia", "magenta"}) {
+		t.Error("expected fuchsia and magenta, got", names)
+	}
+	names, _ = RGBPercentToNames([]string{"50.2%", "50.2%", "50.2%"}, CSS3, PreferGrey)
+	if !equalNames(names, []string{"grey", "gray"}) {
+		t.Error("expected grey and gray, got", names)
+	}
+	if _, err := RGBToNames([]int{1, 2}, CSS3); err == nil {
+		t.Error("expected an error for a short triplet")
+	}
+}
@@ -0,0 +1,131 @@
+package webcolors
+
+import (
+	"encoding/hex"
+)
+
+// # Alpha-aware conversions.
+// #################################################################
+//
+// These variants work on rgba quadruplets: the alpha channel follows the
+// red, green and blue channels and is given on the same scale as them,
+// 0-255 for integer quadruplets and 0%-100% for percentage quadruplets,
+// matching its encoding in 4 and 8 digit hexadecimal values.
+
+// NormalizeHexAlpha Normalize a hexadecimal color value to 8 digits, lowercase,
+// opaque values getting an alpha channel of ff.
+// Malformed values normalize to the empty string; use ParseHexAlpha to get an error instead.
+func NormalizeHexAlpha(hexValue string) string {
+	normalized := NormalizeHex(hexValue)
+	if len(normalized) == 7 {
+		return normalized + "ff"
+	}
+	return normalized
+}
+
+// ParseHexAlpha Validate a hexadecimal color value and normalize it as NormalizeHexAlpha does,
+// returning an ErrInvalidHex error for malformed values
+func ParseHexAlpha(hexValue string) (string, error) {
+	if err := ValidateHex(hexValue); err != nil {
+		return "", err
+	}
+	return NormalizeHexAlpha(hexValue), nil
+}
+
+// NormalizeIntegerQuadruplet Normalize an integer rgba quadruplet so that all values are within the range 0-255 inclusive.
+//
+// Values past the fourth are dropped; a quadruplet with fewer than 4 values is normalized as far as it goes.
+func NormalizeIntegerQuadruplet(rgbaQuadruplet []int) []int {
+	quadruplet := []int{}
+	for i := 0; i < 4 && i < len(rgbaQuadruplet); i++ {
+		quadruplet = append(quadruplet, normalizeIntegerRGB(rgbaQuadruplet[i]))
+	}
+	return quadruplet
+}
+
+// checkQuadruplet Internal helper checking that a quadruplet has exactly 4 values
+func checkQuadruplet(length int) error {
+	if length != 4 {
+		return arityError(length, "4 values for an rgba quadruplet")
+	}
+	return nil
+}
+
+// NameToRGBA Convert a color name to a 4-tuple of integers suitable for use in an rgba quadruplet specifying that color
+func NameToRGBA(name string, spec string) ([]int, error) {
+	hx, err := NameToHex(name, spec)
+	if err != nil {
+		return []int{}, err
+	}
+	return HexToRGBA(hx)
+}
+
+// HexToRGBA Convert a hexadecimal color value to a 4-tuple of integers suitable for use in an rgba quadruplet specifying that color
+func HexToRGBA(hexValue string) ([]int, error) {
+	hexDigits, err := ParseHexAlpha(hexValue)
+	if err != nil {
+		return []int{}, err
+	}
+	rgbaTuple, err := HexToRGB(hexDigits[:7])
+	if err != nil {
+		return rgbaTuple, err
+	}
+	partialHex, err := hex.DecodeString(hexDigits[7:9])
+	if err != nil {
+		return rgbaTuple, err
+	}
+	return append(rgbaTuple, ByteToInt(partialHex)), nil
+}
+
+// HexToRGBAPercent Convert a hexadecimal color value to a 4-tuple of percentages suitable for use in an rgba quadruplet representing that color
+func HexToRGBAPercent(hexValue string) ([]string, error) {
+	rgba, err := HexToRGBA(hexValue)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBAToRGBAPercent(rgba)
+}
+
+// RGBAToHex Convert a 4-tuple of integers, suitable for use in an rgba color quadruplet, to an 8 digit hexadecimal value for that color
+//
+// Quadruplets with fewer than 4 values convert to the empty string.
+func RGBAToHex(rgbaQuadruplet []int) string {
+	quadruplet := NormalizeIntegerQuadruplet(rgbaQuadruplet)
+	if len(quadruplet) < 4 {
+		return ""
+	}
+	return RGBToHex(quadruplet[:3]) + hex.EncodeToString([]byte{byte(quadruplet[3])})
+}
+
+// RGBAToRGBAPercent Convert a 4-tuple of integers, suitable for use in an rgba color quadruplet, to a 4-tuple of percentages suitable for use in representing that color
+func RGBAToRGBAPercent(rgbaQuadruplet []int) ([]string, error) {
+	if err := checkQuadruplet(len(rgbaQuadruplet)); err != nil {
+		return []string{}, err
+	}
+	rgbPercent, err := RGBToRGBPercent(rgbaQuadruplet[:3])
+	if err != nil {
+		return []string{}, err
+	}
+	alphaPercent, err := RGBToRGBPercent([]int{rgbaQuadruplet[3], 0, 0})
+	if err != nil {
+		return []string{}, err
+	}
+	return append(rgbPercent, alphaPercent[0]), nil
+}
+
+// RGBAPercentToHex Convert a 4-tuple of percentages, suitable for use in an rgba color quadruplet, to an 8 digit hexadecimal value for that color
+func RGBAPercentToHex(rgbaPercentQuadruplet []string) (string, error) {
+	rgba, err := RGBAPercentToRGBA(rgbaPercentQuadruplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBAToHex(rgba), nil
+}
+
+// RGBAPercentToRGBA Convert a 4-tuple of percentages, suitable for use in an rgba color quadruplet, to a 4-tuple of integers suitable for use in representing that color
+func RGBAPercentToRGBA(rgbaPercentQuadruplet []string) ([]int, error) {
+	if err := checkQuadruplet(len(rgbaPercentQuadruplet)); err != nil {
+		return []int{}, err
+	}
+	return percentsToIntegers(rgbaPercentQuadruplet)
+}
@@ -0,0 +1,88 @@
+package webcolors
+
+import "testing"
+
+func TestNormalizeHexAlpha(t *testing.T) {
+	value := NormalizeHexAlpha("#09C")
+	if value != "#0099ccff" {
+		t.Error("expected #0099ccff, got", value)
+	}
+	value = NormalizeHex("#09C8")
+	if value != "#0099cc88" {
+		t.Error("expected #0099cc88, got", value)
+	}
+	value = NormalizeHex("#0099CCFF")
+	if value != "#0099cc" {
+		t.Error("expected #0099cc, got", value)
+	}
+}
+
+func TestNameToRGBA(t *testing.T) {
+	value, _ := NameToRGBA("transparent", "css3")
+	expected := []int{0, 0, 0, 0}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHexToRGBA(t *testing.T) {
+	value, _ := HexToRGBA("#00008080")
+	expected := []int{0, 0, 128, 128}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHexToRGBAPercent(t *testing.T) {
+	value, _ := HexToRGBAPercent("#0008")
+	expected := []string{"0%", "0%", "0%", "53.33%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestRGBAToHex(t *testing.T) {
+	value := RGBAToHex([]int{0, 0, 128, 300})
+	if value != "#000080ff" {
+		t.Error("expected #000080ff, got", value)
+	}
+	if value = RGBAToHex([]int{0, 0, 128}); value != "" {
+		t.Error("expected an empty value for a short quadruplet, got", value)
+	}
+	if value := NormalizeIntegerQuadruplet([]int{300, -1}); len(value) != 2 || value[0] != 255 || value[1] != 0 {
+		t.Error("expected [255 0], got", value)
+	}
+}
+
+func TestRGBAPercentToRGBA(t *testing.T) {
+	value, _ := RGBAPercentToRGBA([]string{"0%", "0%", "50%", "50%"})
+	expected := []int{0, 0, 128, 128}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+	if _, err := RGBAPercentToRGBA([]string{"0%", "0%", "50%"}); err == nil {
+		t.Error("expected an error for a triplet")
+	}
+}
+
+func TestRGBAPercentToHex(t *testing.T) {
+	value, _ := RGBAPercentToHex([]string{"100%", "100%", "0%", "0%"})
+	if value != "#ffff0000" {
+		t.Error("expected #ffff0000, got", value)
+	}
+}
+
+func TestTransparentName(t *testing.T) {
+	value, _ := HexToName("#0000", "css3")
+	if value != "transparent" {
+		t.Error("expected transparent, got", value)
+	}
+}
@@ -0,0 +1,145 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+)
+
+// # APCA lightness contrast.
+// #################################################################
+//
+// The Accessible Perceptual Contrast Algorithm of the WCAG 3 drafts rates the
+// contrast of text over a background with a lightness contrast Lc, from about
+// 106 for black text on white to about -108 for white text on black; its sign
+// tells the polarity, positive for dark text on a light background. Its
+// constants are those of APCA-W3 0.1.9.
+//
+// https://github.com/Myndex/apca-w3
+
+// Constants of APCA-W3 0.1.9
+const (
+	apcaMainTRC     = 2.4
+	apcaRedCoef     = 0.2126729
+	apcaGreenCoef   = 0.7151522
+	apcaBlueCoef    = 0.0721750
+	apcaNormBG      = 0.56
+	apcaNormText    = 0.57
+	apcaRevText     = 0.62
+	apcaRevBG       = 0.65
+	apcaBlackThresh = 0.022
+	apcaBlackClamp  = 1.414
+	apcaScaleBoW    = 1.14
+	apcaScaleWoB    = 1.14
+	apcaLoBoWOffset = 0.027
+	apcaLoWoBOffset = 0.027
+	apcaDeltaYMin   = 0.0005
+	apcaLoClip      = 0.1
+)
+
+// APCALuminance Compute the APCA screen luminance Y of a color, clipping it to the
+// sRGB gamut and ignoring its alpha
+func APCALuminance(c Color) float64 {
+	rgb := clampChannels(c.srgb())
+	return apcaRedCoef*math.Pow(rgb[0], apcaMainTRC) +
+		apcaGreenCoef*math.Pow(rgb[1], apcaMainTRC) +
+		apcaBlueCoef*math.Pow(rgb[2], apcaMainTRC)
+}
+
+// apcaSoftClamp Internal helper softly clamping the luminance of near black colors
+func apcaSoftClamp(y float64) float64 {
+	if y < apcaBlackThresh {
+		return y + math.Pow(apcaBlackThresh-y, apcaBlackClamp)
+	}
+	return y
+}
+
+// APCAContrast Compute the APCA lightness contrast Lc of text over a background,
+// positive for dark text on a light background and negative for light text on a
+// dark background. Translucent text is composited over the background, and a
+// translucent background over white.
+func APCAContrast(text, bg Color) float64 {
+	white := Color{Space: SRGB, Channels: [3]float64{1, 1, 1}, Alpha: 1}
+	bg = Composite(bg, white)
+	text = Composite(text, bg)
+	yText, yBG := apcaSoftClamp(APCALuminance(text)), apcaSoftClamp(APCALuminance(bg))
+	if math.Abs(yBG-yText) < apcaDeltaYMin {
+		return 0
+	}
+	var lc float64
+	if yBG > yText {
+		sapc := (math.Pow(yBG, apcaNormBG) - math.Pow(yText, apcaNormText)) * apcaScaleBoW
+		if sapc >= apcaLoClip {
+			lc = sapc - apcaLoBoWOffset
+		}
+	} else {
+		sapc := (math.Pow(yBG, apcaRevBG) - math.Pow(yText, apcaRevText)) * apcaScaleWoB
+		if sapc <= -apcaLoClip {
+			lc = sapc + apcaLoWoBOffset
+		}
+	}
+	return lc * 100
+}
+
+// APCA font sizes with special meanings in the font lookup table
+const (
+	// APCAProhibited the contrast is too low for any use
+	APCAProhibited = 999
+	// APCANonText the contrast only suffices for non-text elements such as dividers
+	APCANonText = 777
+)
+
+// apcaFontSizes the minimum font sizes in px of APCA-W3 0.1.9, by rows of Lc in
+// steps of 5, starting with 0, and columns of font weights from 100 to 900
+var apcaFontSizes = [][9]float64{
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},
+	{999, 999, 999, 999, 999, 999, 999, 999, 999},
+	{777, 777, 777, 777, 777, 777, 777, 777, 777},
+	{777, 777, 777, 777, 777, 777, 777, 777, 777},
+	{777, 777, 777, 120, 120, 108, 96, 96, 96},
+	{777, 777, 120, 108, 108, 96, 72, 72, 72},
+	{777, 120, 108, 96, 72, 60, 48, 48, 48},
+	{120, 108, 96, 60, 48, 42, 32, 32, 32},
+	{108, 96, 72, 42, 32, 28, 24, 24, 24},
+	{96, 72, 60, 32, 28, 24, 21, 21, 21},
+	{80, 60, 48, 28, 24, 21, 18, 18, 18},
+	{72, 48, 42, 24, 21, 18, 16, 16, 18},
+	{68, 46, 32, 21.75, 19, 17, 15, 16, 18},
+	{64, 44, 28, 19.5, 18, 16, 14.5, 16, 18},
+	{60, 42, 24, 18, 16, 15, 14, 16, 18},
+	{56, 38.25, 23, 17.25, 15.81, 14.81, 14, 16, 18},
+	{52, 34.5, 22, 16.5, 15.625, 14.625, 14, 16, 18},
+	{48, 32, 21, 16, 15.5, 14.5, 14, 16, 18},
+	{45, 28, 19.5, 15.5, 15, 14, 13.5, 16, 18},
+	{42, 26.5, 18.5, 15, 14.5, 13.5, 13, 16, 18},
+	{39, 25, 18, 14.5, 14, 13, 12, 16, 18},
+	{36, 24, 18, 14, 13, 12, 11, 16, 18},
+	{34.5, 22.5, 17.25, 12.5, 11.875, 11.25, 10.625, 14.5, 16.5},
+	{33, 21, 16.5, 11, 10.75, 10.5, 10.25, 13, 15},
+	{32, 20, 16, 10, 10, 10, 10, 12, 14},
+}
+
+// APCAFontSize Look up the minimum font size in px that text of a font weight, from
+// 100 to 900 in steps of 100, needs at a lightness contrast of either polarity;
+// the contrast is rounded down to the nearest row of the table. APCAProhibited
+// and APCANonText are returned when the contrast does not suffice for text.
+func APCAFontSize(lc float64, weight int) (float64, error) {
+	if weight < 100 || weight > 900 || weight%100 != 0 {
+		return 0, invalidValueError(strconv.Itoa(weight), "a font weight from 100 to 900 in steps of 100")
+	}
+	row := int(math.Abs(lc) / 5)
+	if row >= len(apcaFontSizes) {
+		row = len(apcaFontSizes) - 1
+	}
+	return apcaFontSizes[row][weight/100-1], nil
+}
+
+// APCAReadable Report whether text of a font size in px and a font weight is readable
+// over a background according to the APCA font lookup table
+func APCAReadable(text, bg Color, fontSize float64, weight int) (bool, error) {
+	minimum, err := APCAFontSize(APCAContrast(text, bg), weight)
+	if err != nil {
+		return false, err
+	}
+	return minimum != APCAProhibited && minimum != APCANonText && fontSize >= minimum, nil
+}
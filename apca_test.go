@@ -0,0 +1,69 @@
+package webcolors
+
+import "testing"
+
+func TestAPCAContrast(t *testing.T) {
+	// reference values of the APCA-W3 0.1.9 test suite
+	expected := []struct {
+		text, bg Hex
+		lc       float64
+	}{
+		{"#888", "#fff", 63.056469930209424},
+		{"#fff", "#888", -68.54146436644962},
+		{"#000", "#aaa", 58.146262578561334},
+		{"#aaa", "#000", -56.24113336839742},
+		{"#123", "#def", 91.66830811481631},
+		{"#def", "#123", -93.06770049484275},
+		{"#123", "#444", 8.32326136957393},
+		{"#444", "#123", -7.526878460278154},
+	}
+	for _, e := range expected {
+		text, _ := e.text.Color()
+		bg, _ := e.bg.Color()
+		if value := APCAContrast(text, bg); !closeTo(value, e.lc, 1e-9) {
+			t.Error(e.text, "on", e.bg, "expected", e.lc, "got", value)
+		}
+	}
+}
+
+func TestAPCAContrastIdentical(t *testing.T) {
+	gray, _ := Hex("#777").Color()
+	if value := APCAContrast(gray, gray); value != 0 {
+		t.Error("expected 0, got", value)
+	}
+}
+
+func TestAPCAFontSize(t *testing.T) {
+	expected := []struct {
+		lc     float64
+		weight int
+		size   float64
+	}{
+		{90, 400, 16},
+		{-90, 400, 16},
+		{62, 700, 16},
+		{75, 300, 24},
+		{17, 400, APCANonText},
+		{4, 900, APCAProhibited},
+		{140, 100, 32},
+	}
+	for _, e := range expected {
+		if value, _ := APCAFontSize(e.lc, e.weight); value != e.size {
+			t.Error(e.lc, e.weight, "expected", e.size, "got", value)
+		}
+	}
+	if _, err := APCAFontSize(60, 450); err == nil {
+		t.Error("expected an error for a font weight of 450")
+	}
+}
+
+func TestAPCAReadable(t *testing.T) {
+	text, _ := Hex("#888").Color()
+	bg, _ := Hex("#fff").Color()
+	if ok, _ := APCAReadable(text, bg, 16, 700); !ok {
+		t.Error("expected #888 on #fff to be readable at 16px bold")
+	}
+	if ok, _ := APCAReadable(text, bg, 16, 400); ok {
+		t.Error("expected #888 on #fff not to be readable at 16px regular")
+	}
+}
@@ -0,0 +1,358 @@
+package webcolors
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Typed color values.
+// #################################################################
+
+// Space identifies the color space a Color's channels are expressed in.
+type Space string
+
+// SRGB the sRGB color space, in which all the hexadecimal, integer and
+// percentage rgb formats of the HTML and CSS specifications are defined.
+const SRGB Space = "srgb"
+
+// Color a color value: three channels in a color space plus an alpha channel.
+//
+// For SRGB colors the channels are red, green and blue, each within the
+// range 0-1 inclusive; see the Space constants for the channels of the other
+// color spaces. Alpha ranges from 0 (fully transparent) to 1 (fully
+// opaque). A zero Space is treated as SRGB.
+//
+// Color implements image/color.Color.
+type Color struct {
+	Space    Space
+	Channels [3]float64
+	Alpha    float64
+}
+
+// IntegerRGB an integer rgb triplet, channels within the range 0-255 inclusive once normalized.
+type IntegerRGB struct {
+	R, G, B int
+}
+
+// PercentRGB a percentage rgb triplet, channels within the range 0-100 inclusive once normalized.
+type PercentRGB struct {
+	R, G, B float64
+}
+
+// Hex a hexadecimal color value such as "#0099cc" or "#09c".
+type Hex string
+
+// ColorModel a color.Model converting any image/color.Color to a Color.
+var ColorModel = color.ModelFunc(colorModel)
+
+// colorModel Internal helper backing ColorModel
+func colorModel(c color.Color) color.Color {
+	if c, ok := c.(Color); ok {
+		return c
+	}
+	r, g, b, a := c.RGBA()
+	if a == 0 {
+		return Color{Space: SRGB}
+	}
+	// image/color values are alpha-premultiplied.
+	return Color{
+		Space:    SRGB,
+		Channels: [3]float64{float64(r) / float64(a), float64(g) / float64(a), float64(b) / float64(a)},
+		Alpha:    float64(a) / 0xffff,
+	}
+}
+
+// NewColor Build a Color from its space, channels and alpha
+func NewColor(space Space, channels [3]float64, alpha float64) Color {
+	return Color{Space: space, Channels: channels, Alpha: alpha}
+}
+
+// NameToColor Convert a color name to a Color
+func NameToColor(name string, spec string) (Color, error) {
+	hx, err := NameToHex(name, spec)
+	if err != nil {
+		return Color{}, err
+	}
+	return Hex(hx).Color()
+}
+
+// ToColor Convert any color representation of the package to a Color: a Color, a Hex,
+// a triplet type such as IntegerRGB, PercentRGB, HSL or OKLCH, an image/color.Color,
+// a string holding a CSS <color> such as a name or hexadecimal value, an integer rgb
+// triplet or rgba quadruplet, or a percentage rgb triplet or rgba quadruplet.
+func ToColor(value interface{}, spec string) (Color, error) {
+	switch v := value.(type) {
+	case Color:
+		return v, nil
+	case Hex:
+		return v.Color()
+	case interface{ Color() Color }:
+		return v.Color(), nil
+	case color.Color:
+		return colorModel(v).(Color), nil
+	case string:
+		return ParseColor(v, spec)
+	case []int:
+		if len(v) == 4 {
+			return Hex(RGBAToHex(v)).Color()
+		}
+		rgb, err := IntegerRGBFromSlice(v)
+		if err != nil {
+			return Color{}, err
+		}
+		return rgb.Color(), nil
+	case []string:
+		if len(v) == 4 {
+			hx, err := RGBAPercentToHex(v)
+			if err != nil {
+				return Color{}, err
+			}
+			return Hex(hx).Color()
+		}
+		rgb, err := PercentRGBFromSlice(v)
+		if err != nil {
+			return Color{}, err
+		}
+		return rgb.Color(), nil
+	}
+	return Color{}, &ColorError{Err: ErrInvalidValue, Value: fmt.Sprintf("%T", value), Expected: "a type convertible to a color"}
+}
+
+// srgb Internal helper returning the sRGB channels of the color, which may be out of gamut
+func (c Color) srgb() [3]float64 {
+	return c.convert(SRGB).Channels
+}
+
+// RGBA Implement image/color.Color, returning alpha-premultiplied 16-bit channels
+func (c Color) RGBA() (r, g, b, a uint32) {
+	ch := c.srgb()
+	alpha := clampUnit(c.Alpha)
+	a = uint32(math.Round(alpha * 0xffff))
+	r = uint32(math.Round(clampUnit(ch[0]) * alpha * 0xffff))
+	g = uint32(math.Round(clampUnit(ch[1]) * alpha * 0xffff))
+	b = uint32(math.Round(clampUnit(ch[2]) * alpha * 0xffff))
+	return r, g, b, a
+}
+
+// IntegerRGB Convert the color to an integer rgb triplet, clamping out of range channels
+func (c Color) IntegerRGB() IntegerRGB {
+	ch := c.srgb()
+	return IntegerRGB{unitToInteger(ch[0]), unitToInteger(ch[1]), unitToInteger(ch[2])}
+}
+
+// PercentRGB Convert the color to a percentage rgb triplet, clamping out of range channels
+func (c Color) PercentRGB() PercentRGB {
+	ch := c.srgb()
+	return PercentRGB{clampUnit(ch[0]) * 100, clampUnit(ch[1]) * 100, clampUnit(ch[2]) * 100}
+}
+
+// Hex Convert the color to a normalized hexadecimal value, of 8 digits when the color is translucent
+func (c Color) Hex() Hex {
+	return Hex(NormalizeHex(RGBAToHex(append(c.IntegerRGB().Slice(), unitToInteger(c.Alpha)))))
+}
+
+// Name Convert the color to its corresponding normalized color name, if any such name exists
+func (c Color) Name(spec string) (string, error) {
+	return c.Hex().Name(spec)
+}
+
+// clampUnit Internal helper clamping a value to the range 0-1 inclusive
+func clampUnit(value float64) float64 {
+	if value < 0 || math.IsNaN(value) {
+		return 0
+	} else if value > 1 {
+		return 1
+	}
+	return value
+}
+
+// unitToInteger Internal helper converting a 0-1 channel to an integer between 0 and 255 inclusive
+func unitToInteger(value float64) int {
+	return int(math.Round(clampUnit(value) * 255))
+}
+
+// IntegerRGBFromSlice Convert a 3-tuple of integers to an IntegerRGB
+func IntegerRGBFromSlice(rgbTriplet []int) (IntegerRGB, error) {
+	if len(rgbTriplet) != 3 {
+		return IntegerRGB{}, arityError(len(rgbTriplet), "3 values for an integer rgb triplet")
+	}
+	return IntegerRGB{rgbTriplet[0], rgbTriplet[1], rgbTriplet[2]}, nil
+}
+
+// Slice Convert the triplet to a 3-tuple of integers
+func (t IntegerRGB) Slice() []int {
+	return []int{t.R, t.G, t.B}
+}
+
+// Normalize Normalize the triplet so that all values are within the range 0-255 inclusive
+func (t IntegerRGB) Normalize() IntegerRGB {
+	return IntegerRGB{normalizeIntegerRGB(t.R), normalizeIntegerRGB(t.G), normalizeIntegerRGB(t.B)}
+}
+
+// Hex Convert the triplet to a normalized hexadecimal value
+func (t IntegerRGB) Hex() Hex {
+	return Hex(RGBToHex(t.Slice()))
+}
+
+// Percent Convert the triplet to a percentage rgb triplet
+func (t IntegerRGB) Percent() PercentRGB {
+	n := t.Normalize()
+	return PercentRGB{integerToPercent(n.R), integerToPercent(n.G), integerToPercent(n.B)}
+}
+
+// Name Convert the triplet to its corresponding normalized color name, if any such name exists
+func (t IntegerRGB) Name(spec string) (string, error) {
+	return t.Hex().Name(spec)
+}
+
+// Color Convert the triplet to an opaque Color
+func (t IntegerRGB) Color() Color {
+	n := t.Normalize()
+	return Color{
+		Space:    SRGB,
+		Channels: [3]float64{float64(n.R) / 255, float64(n.G) / 255, float64(n.B) / 255},
+		Alpha:    1,
+	}
+}
+
+// integerToPercent Internal helper converting an integer between 0 and 255 inclusive to a percentage.
+//
+// Like RGBToRGBPercent, the values that are exact halvings of 255
+// are mapped to their nominal percentages.
+func integerToPercent(value int) float64 {
+	specials := map[int]float64{
+		255: 100,
+		128: 50,
+		64:  25,
+		32:  12.5,
+		16:  6.25,
+		0:   0,
+	}
+	if p, ok := specials[value]; ok {
+		return p
+	}
+	return (float64(value) / 255.0) * 100
+}
+
+// PercentRGBFromSlice Convert a 3-tuple of percentages such as "50%" to a PercentRGB
+func PercentRGBFromSlice(rgbPercentTriplet []string) (PercentRGB, error) {
+	if len(rgbPercentTriplet) != 3 {
+		return PercentRGB{}, arityError(len(rgbPercentTriplet), "3 values for a percentage rgb triplet")
+	}
+	var values [3]float64
+	for i := range rgbPercentTriplet {
+		num, err := parsePercent(rgbPercentTriplet[i])
+		if err != nil {
+			return PercentRGB{}, err
+		}
+		values[i] = num
+	}
+	return PercentRGB{values[0], values[1], values[2]}, nil
+}
+
+// parsePercent Internal helper parsing a percentage such as "50%" to a number
+func parsePercent(value string) (float64, error) {
+	num, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+	if err != nil {
+		return 0, &ColorError{Err: ErrInvalidPercent, Value: value}
+	}
+	return num, nil
+}
+
+// Strings Convert the triplet to a 3-tuple of percentages such as "50%"
+func (t PercentRGB) Strings() []string {
+	return []string{formatPercent(t.R), formatPercent(t.G), formatPercent(t.B)}
+}
+
+// formatPercent Internal helper formatting a percentage the way RGBToRGBPercent does
+func formatPercent(value float64) string {
+	return strconv.FormatFloat(value, 'g', 4, 64) + "%"
+}
+
+// Normalize Normalize the triplet so that all values are within the range 0-100 inclusive
+func (t PercentRGB) Normalize() PercentRGB {
+	return PercentRGB{clampUnit(t.R/100) * 100, clampUnit(t.G/100) * 100, clampUnit(t.B/100) * 100}
+}
+
+// RGB Convert the triplet to an integer rgb triplet
+func (t PercentRGB) RGB() IntegerRGB {
+	n := t.Normalize()
+	return IntegerRGB{percentValueToInteger(n.R), percentValueToInteger(n.G), percentValueToInteger(n.B)}
+}
+
+// percentValueToInteger Internal helper converting a percentage to an integer between 0 and 255 inclusive,
+// rounding halves up like percentToInteger
+func percentValueToInteger(value float64) int {
+	return int(math.Floor(255*(value/100.0) + 0.5))
+}
+
+// Hex Convert the triplet to a normalized hexadecimal value
+func (t PercentRGB) Hex() Hex {
+	return t.RGB().Hex()
+}
+
+// Name Convert the triplet to its corresponding normalized color name, if any such name exists
+func (t PercentRGB) Name(spec string) (string, error) {
+	return t.RGB().Name(spec)
+}
+
+// Color Convert the triplet to an opaque Color
+func (t PercentRGB) Color() Color {
+	n := t.Normalize()
+	return Color{Space: SRGB, Channels: [3]float64{n.R / 100, n.G / 100, n.B / 100}, Alpha: 1}
+}
+
+// Normalize Normalize the hexadecimal value to 6 digits, or 8 digits when translucent, lowercase
+func (h Hex) Normalize() (Hex, error) {
+	n, err := ParseHex(string(h))
+	return Hex(n), err
+}
+
+// RGB Convert the hexadecimal value to an integer rgb triplet; translucent values are an error
+func (h Hex) RGB() (IntegerRGB, error) {
+	n, err := h.Normalize()
+	if err != nil {
+		return IntegerRGB{}, err
+	}
+	rgb, err := HexToRGB(string(n))
+	if err != nil {
+		return IntegerRGB{}, err
+	}
+	return IntegerRGBFromSlice(rgb)
+}
+
+// Percent Convert the hexadecimal value to a percentage rgb triplet
+func (h Hex) Percent() (PercentRGB, error) {
+	rgb, err := h.RGB()
+	if err != nil {
+		return PercentRGB{}, err
+	}
+	return rgb.Percent(), nil
+}
+
+// Name Convert the hexadecimal value to its corresponding normalized color name, if any such name exists
+func (h Hex) Name(spec string) (string, error) {
+	n, err := h.Normalize()
+	if err != nil {
+		return "", err
+	}
+	return HexToName(string(n), spec)
+}
+
+// Color Convert the hexadecimal value to a Color
+func (h Hex) Color() (Color, error) {
+	n, err := h.Normalize()
+	if err != nil {
+		return Color{}, err
+	}
+	rgba, err := HexToRGBA(string(n))
+	if err != nil {
+		return Color{}, err
+	}
+	c := IntegerRGB{rgba[0], rgba[1], rgba[2]}.Color()
+	c.Alpha = float64(rgba[3]) / 255
+	return c, nil
+}
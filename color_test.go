@@ -0,0 +1,79 @@
+package webcolors
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestColorImplementsImageColor(t *testing.T) {
+	var c color.Color = IntegerRGB{255, 0, 128}.Color()
+	r, g, b, a := c.RGBA()
+	if r != 0xffff || g != 0 || b != 0x8080 || a != 0xffff {
+		t.Error("expected ffff 0 8080 ffff, got", r, g, b, a)
+	}
+}
+
+func TestColorModel(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	img.Set(0, 0, color.NRGBA{0, 0, 128, 255})
+	value := ColorModel.Convert(img.At(0, 0)).(Color)
+	if value.Hex() != "#000080" {
+		t.Error("expected #000080, got", value.Hex())
+	}
+}
+
+func TestNameToColor(t *testing.T) {
+	value, _ := NameToColor("navy", "css3")
+	expected := IntegerRGB{0, 0, 128}
+	if value.IntegerRGB() != expected {
+		t.Error("expected", expected, "got", value.IntegerRGB())
+	}
+}
+
+func TestIntegerRGBFromSlice(t *testing.T) {
+	if _, err := IntegerRGBFromSlice([]int{1, 2}); err == nil {
+		t.Error("expected an error for a short triplet")
+	}
+	value, _ := IntegerRGBFromSlice([]int{270, -20, 128})
+	expected := IntegerRGB{255, 0, 128}
+	if value.Normalize() != expected {
+		t.Error("expected", expected, "got", value.Normalize())
+	}
+}
+
+func TestIntegerRGBPercent(t *testing.T) {
+	value := IntegerRGB{218, 165, 32}.Percent().Strings()
+	expected := []string{"85.49%", "64.71%", "12.5%"}
+	for i := range value {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestPercentRGBFromSlice(t *testing.T) {
+	value, _ := PercentRGBFromSlice([]string{"85.49%", "64.71%", "12.5%"})
+	name, _ := value.Name("css3")
+	if name != "goldenrod" {
+		t.Error("expected goldenrod, got", name)
+	}
+}
+
+func TestHexNormalize(t *testing.T) {
+	if _, err := Hex("#12").Normalize(); err == nil {
+		t.Error("expected an error for #12")
+	}
+	value, _ := Hex("#09C").Normalize()
+	if value != "#0099cc" {
+		t.Error("expected #0099cc, got", value)
+	}
+}
+
+func TestHexColor(t *testing.T) {
+	value, _ := Hex("#daa520").Color()
+	name, _ := value.Name("css3")
+	if name != "goldenrod" {
+		t.Error("expected goldenrod, got", name)
+	}
+}
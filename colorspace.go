@@ -0,0 +1,278 @@
+package webcolors
+
+import (
+	"math"
+)
+
+// # Color space conversions.
+// #################################################################
+//
+// The matrices and transfer functions below are those of the sample code of
+// the CSS Color specification, with XYZ relative to the D65 white point as
+// the connection space:
+//
+// https://www.w3.org/TR/css-color-4/#color-conversion-code
+
+const (
+	// LabSpace the CIE Lab color space, relative to the D50 white point
+	LabSpace Space = "lab"
+	// LCHSpace the polar form of LabSpace: lightness, chroma and hue
+	LCHSpace Space = "lch"
+	// OKLabSpace the Oklab color space, relative to the D65 white point
+	OKLabSpace Space = "oklab"
+	// OKLCHSpace the polar form of OKLabSpace: lightness, chroma and hue
+	OKLCHSpace Space = "oklch"
+)
+
+// spaceConversion the conversions of a color space to and from D65 XYZ
+type spaceConversion struct {
+	toXYZ   func([3]float64) [3]float64
+	fromXYZ func([3]float64) [3]float64
+	// base, when set, the space this one is a form of, such as sRGB for hsl;
+	// toBase and fromBase then convert to and from it without a round trip through XYZ
+	base     Space
+	toBase   func([3]float64) [3]float64
+	fromBase func([3]float64) [3]float64
+}
+
+// spaceConversions the conversions of every supported color space
+var spaceConversions = map[Space]spaceConversion{
+	SRGB: {
+		toXYZ:   func(c [3]float64) [3]float64 { return linearSRGBToXYZ.mul(srgbToLinear(c)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return linearToSRGB(xyzToLinearSRGB.mul(c)) },
+	},
+	LabSpace: {
+		toXYZ:   func(c [3]float64) [3]float64 { return d50ToD65.mul(labToXYZD50(c)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return xyzD50ToLab(d65ToD50.mul(c)) },
+	},
+	LCHSpace: {
+		toXYZ:   func(c [3]float64) [3]float64 { return d50ToD65.mul(labToXYZD50(polarToRectangular(c))) },
+		fromXYZ: func(c [3]float64) [3]float64 { return rectangularToPolar(xyzD50ToLab(d65ToD50.mul(c))) },
+	},
+	OKLabSpace: {
+		toXYZ:   oklabToXYZ,
+		fromXYZ: xyzToOKLab,
+	},
+	OKLCHSpace: {
+		toXYZ:   func(c [3]float64) [3]float64 { return oklabToXYZ(polarToRectangular(c)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return rectangularToPolar(xyzToOKLab(c)) },
+	},
+}
+
+// normalizedSpace Internal helper resolving the zero Space to SRGB
+func normalizedSpace(space Space) Space {
+	if space == "" {
+		return SRGB
+	}
+	return space
+}
+
+// Convert Convert the color to another color space. Channels are not clamped,
+// so colors outside the gamut of the target space keep out of range channels.
+func (c Color) Convert(space Space) (Color, error) {
+	from, ok := spaceConversions[normalizedSpace(c.Space)]
+	if !ok {
+		return Color{}, invalidValueError(string(c.Space), "a supported color space")
+	}
+	to, ok := spaceConversions[normalizedSpace(space)]
+	if !ok {
+		return Color{}, invalidValueError(string(space), "a supported color space")
+	}
+	src, dst := normalizedSpace(c.Space), normalizedSpace(space)
+	channels := c.Channels
+	if src != dst && from.base != "" {
+		channels, src = from.toBase(channels), from.base
+		from = spaceConversions[src]
+	}
+	if src != dst {
+		if to.base == src {
+			channels = to.fromBase(channels)
+		} else {
+			channels = to.fromXYZ(from.toXYZ(channels))
+		}
+	}
+	return Color{Space: dst, Channels: channels, Alpha: c.Alpha}, nil
+}
+
+// convert Internal helper converting the color to a supported color space;
+// colors of unsupported spaces, such as CurrentColor, are returned unchanged
+func (c Color) convert(space Space) Color {
+	converted, err := c.Convert(space)
+	if err != nil {
+		return c
+	}
+	return converted
+}
+
+// matrix3 a 3x3 matrix, applied to column vectors
+type matrix3 [3][3]float64
+
+// mul Internal helper multiplying a vector by the matrix
+func (m matrix3) mul(v [3]float64) [3]float64 {
+	return [3]float64{
+		m[0][0]*v[0] + m[0][1]*v[1] + m[0][2]*v[2],
+		m[1][0]*v[0] + m[1][1]*v[1] + m[1][2]*v[2],
+		m[2][0]*v[0] + m[2][1]*v[1] + m[2][2]*v[2],
+	}
+}
+
+// whiteD50 the D50 white point, in XYZ
+var whiteD50 = [3]float64{0.3457 / 0.3585, 1, (1 - 0.3457 - 0.3585) / 0.3585}
+
+// linearSRGBToXYZ conversion from linear-light sRGB to D65 XYZ
+var linearSRGBToXYZ = matrix3{
+	{506752.0 / 1228815, 87881.0 / 245763, 12673.0 / 70218},
+	{87098.0 / 409605, 175762.0 / 245763, 12673.0 / 175545},
+	{7918.0 / 409605, 87881.0 / 737289, 1001167.0 / 1053270},
+}
+
+// xyzToLinearSRGB conversion from D65 XYZ to linear-light sRGB
+var xyzToLinearSRGB = matrix3{
+	{12831.0 / 3959, -329.0 / 214, -1974.0 / 3959},
+	{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+	{705.0 / 12673, -2585.0 / 12673, 705.0 / 667},
+}
+
+// Bradford chromatic adaptation between the D65 and D50 white points
+var (
+	d65ToD50 = matrix3{
+		{1.0479297925449969, 0.022946870601609652, -0.05019226628920524},
+		{0.02962780877005599, 0.9904344267538799, -0.017073799063418826},
+		{-0.009243040646204504, 0.015055191490298152, 0.7518742814281371},
+	}
+	d50ToD65 = matrix3{
+		{0.955473421488075, -0.02309845494876471, 0.06325924320057072},
+		{-0.0283697093338637, 1.0099953980813041, 0.021041441191917323},
+		{0.012314014864481998, -0.020507649298898964, 1.330365926242124},
+	}
+)
+
+// Conversions between D65 XYZ, the cone responses (LMS) of Oklab and Oklab
+var (
+	xyzToLMS = matrix3{
+		{0.8190224379967030, 0.3619062600528904, -0.1288737815209879},
+		{0.0329836539323885, 0.9292868615863434, 0.0361446663506424},
+		{0.0481771893596242, 0.2642395317527308, 0.6335478284694309},
+	}
+	lmsToOKLab = matrix3{
+		{0.2104542683093140, 0.7936177747023054, -0.0040720430116193},
+		{1.9779985324311684, -2.4285922420485799, 0.4505937096174110},
+		{0.0259040424655478, 0.7827717124575296, -0.8086757549230774},
+	}
+	lmsToXYZ = matrix3{
+		{1.2268798758459243, -0.5578149944602171, 0.2813910456659647},
+		{-0.0405757452148008, 1.1122868032803170, -0.0717110580655164},
+		{-0.0763729366746601, -0.4214933324022432, 1.5869240198367816},
+	}
+	oklabToLMS = matrix3{
+		{1.0000000000000000, 0.3963377773761749, 0.2158037573099136},
+		{1.0000000000000000, -0.1055613458156586, -0.0638541728258133},
+		{1.0000000000000000, -0.0894841775298119, -1.2914855480194092},
+	}
+)
+
+// srgbToLinear Internal helper undoing the sRGB transfer function, extended to negative values
+func srgbToLinear(rgb [3]float64) [3]float64 {
+	var linear [3]float64
+	for i, v := range rgb {
+		abs := math.Abs(v)
+		if abs <= 0.04045 {
+			linear[i] = v / 12.92
+		} else {
+			linear[i] = math.Copysign(math.Pow((abs+0.055)/1.055, 2.4), v)
+		}
+	}
+	return linear
+}
+
+// linearToSRGB Internal helper applying the sRGB transfer function, extended to negative values
+func linearToSRGB(linear [3]float64) [3]float64 {
+	var rgb [3]float64
+	for i, v := range linear {
+		abs := math.Abs(v)
+		if abs > 0.0031308 {
+			rgb[i] = math.Copysign(1.055*math.Pow(abs, 1/2.4)-0.055, v)
+		} else {
+			rgb[i] = 12.92 * v
+		}
+	}
+	return rgb
+}
+
+// CIE standard constants of the Lab conversions
+const (
+	labEpsilon = 216.0 / 24389
+	labKappa   = 24389.0 / 27
+)
+
+// xyzD50ToLab Internal helper converting D50 XYZ to CIE Lab
+func xyzD50ToLab(xyz [3]float64) [3]float64 {
+	var f [3]float64
+	for i := range xyz {
+		v := xyz[i] / whiteD50[i]
+		if v > labEpsilon {
+			f[i] = math.Cbrt(v)
+		} else {
+			f[i] = (labKappa*v + 16) / 116
+		}
+	}
+	return [3]float64{116*f[1] - 16, 500 * (f[0] - f[1]), 200 * (f[1] - f[2])}
+}
+
+// labToXYZD50 Internal helper converting CIE Lab to D50 XYZ
+func labToXYZD50(lab [3]float64) [3]float64 {
+	f1 := (lab[0] + 16) / 116
+	f0 := lab[1]/500 + f1
+	f2 := f1 - lab[2]/200
+	var xyz [3]float64
+	if f0*f0*f0 > labEpsilon {
+		xyz[0] = f0 * f0 * f0
+	} else {
+		xyz[0] = (116*f0 - 16) / labKappa
+	}
+	if lab[0] > labKappa*labEpsilon {
+		xyz[1] = f1 * f1 * f1
+	} else {
+		xyz[1] = lab[0] / labKappa
+	}
+	if f2*f2*f2 > labEpsilon {
+		xyz[2] = f2 * f2 * f2
+	} else {
+		xyz[2] = (116*f2 - 16) / labKappa
+	}
+	for i := range xyz {
+		xyz[i] *= whiteD50[i]
+	}
+	return xyz
+}
+
+// xyzToOKLab Internal helper converting D65 XYZ to Oklab
+func xyzToOKLab(xyz [3]float64) [3]float64 {
+	lms := xyzToLMS.mul(xyz)
+	return lmsToOKLab.mul([3]float64{math.Cbrt(lms[0]), math.Cbrt(lms[1]), math.Cbrt(lms[2])})
+}
+
+// oklabToXYZ Internal helper converting Oklab to D65 XYZ
+func oklabToXYZ(oklab [3]float64) [3]float64 {
+	lms := oklabToLMS.mul(oklab)
+	return lmsToXYZ.mul([3]float64{lms[0] * lms[0] * lms[0], lms[1] * lms[1] * lms[1], lms[2] * lms[2] * lms[2]})
+}
+
+// achromaticChroma the chroma under which the hue of a polar color is considered powerless
+const achromaticChroma = 1e-9
+
+// rectangularToPolar Internal helper converting lightness, a and b to lightness, chroma
+// and hue in degrees, the hue of achromatic colors being 0
+func rectangularToPolar(c [3]float64) [3]float64 {
+	chroma := math.Hypot(c[1], c[2])
+	hue := 0.0
+	if chroma > achromaticChroma {
+		hue = normalizeHue(degrees(math.Atan2(c[2], c[1])))
+	}
+	return [3]float64{c[0], chroma, hue}
+}
+
+// polarToRectangular Internal helper converting lightness, chroma and hue in degrees to lightness, a and b
+func polarToRectangular(c [3]float64) [3]float64 {
+	return [3]float64{c[0], c[1] * math.Cos(radians(c[2])), c[1] * math.Sin(radians(c[2]))}
+}
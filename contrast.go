@@ -0,0 +1,120 @@
+package webcolors
+
+// # WCAG 2 contrast.
+// #################################################################
+//
+// The contrast ratio of WCAG 2.x compares the relative luminances of two
+// colors, from 1:1 for identical colors up to 21:1 for black on white.
+// Thresholds apply to the unrounded ratio.
+//
+// https://www.w3.org/TR/WCAG21/#dfn-contrast-ratio
+
+// Minimum contrast ratios of the WCAG 2.x success criteria 1.4.3 (AA) and 1.4.6 (AAA);
+// large text is at least 18 point, or 14 point bold
+const (
+	ContrastAA       = 4.5
+	ContrastAALarge  = 3
+	ContrastAAA      = 7
+	ContrastAAALarge = 4.5
+)
+
+// luminanceFlare the flare added to both luminances of the contrast ratio
+const luminanceFlare = 0.05
+
+// ContrastResult the WCAG 2.x contrast ratio of two colors and the levels it meets
+type ContrastResult struct {
+	Ratio    float64
+	AA       bool
+	AALarge  bool
+	AAA      bool
+	AAALarge bool
+}
+
+// RelativeLuminance Compute the WCAG relative luminance of a color, from 0 for black
+// to 1 for white, clipping it to the sRGB gamut and ignoring its alpha.
+//
+// The sRGB transfer function is undone with the threshold of 0.04045 of the sRGB
+// standard, rather than the 0.03928 of older WCAG texts, which makes no difference
+// for 8-bit colors.
+func RelativeLuminance(c Color) float64 {
+	linear := srgbToLinear(clampChannels(c.srgb()))
+	return 0.2126*linear[0] + 0.7152*linear[1] + 0.0722*linear[2]
+}
+
+// Composite Composite a color over a background with the source-over operator of
+// CSS compositing, in sRGB; the result is opaque when the background is
+func Composite(fg, bg Color) Color {
+	top, bottom := clampChannels(fg.srgb()), clampChannels(bg.srgb())
+	fa, ba := clampUnit(fg.Alpha), clampUnit(bg.Alpha)
+	alpha := fa + ba*(1-fa)
+	c := Color{Space: SRGB, Alpha: alpha}
+	if alpha == 0 {
+		return c
+	}
+	for i := range c.Channels {
+		c.Channels[i] = (top[i]*fa + bottom[i]*ba*(1-fa)) / alpha
+	}
+	return c
+}
+
+// ContrastRatio Compute the WCAG 2.x contrast ratio of a foreground color over a
+// background color, from 1 to 21. A translucent foreground is composited over the
+// background, and a translucent background over white, the initial canvas color.
+// currentcolor has no contrast, and is an error.
+func ContrastRatio(fg, bg Color) (float64, error) {
+	if fg.IsCurrentColor() || bg.IsCurrentColor() {
+		return 0, currentColorError()
+	}
+	white := Color{Space: SRGB, Channels: [3]float64{1, 1, 1}, Alpha: 1}
+	bg = Composite(bg, white)
+	fg = Composite(fg, bg)
+	l1, l2 := RelativeLuminance(fg), RelativeLuminance(bg)
+	if l1 < l2 {
+		l1, l2 = l2, l1
+	}
+	return (l1 + luminanceFlare) / (l2 + luminanceFlare), nil
+}
+
+// MeetsAA Report whether a foreground color over a background color meets WCAG 2.x
+// level AA, for large text when largeText is set; currentcolor meets no level
+func MeetsAA(fg, bg Color, largeText bool) bool {
+	ratio, err := ContrastRatio(fg, bg)
+	if largeText {
+		return err == nil && ratio >= ContrastAALarge
+	}
+	return err == nil && ratio >= ContrastAA
+}
+
+// MeetsAAA Report whether a foreground color over a background color meets WCAG 2.x
+// level AAA, for large text when largeText is set; currentcolor meets no level
+func MeetsAAA(fg, bg Color, largeText bool) bool {
+	ratio, err := ContrastRatio(fg, bg)
+	if largeText {
+		return err == nil && ratio >= ContrastAAALarge
+	}
+	return err == nil && ratio >= ContrastAAA
+}
+
+// CheckContrast Compute the WCAG 2.x contrast of a foreground over a background, both
+// given in any representation ToColor accepts, and the levels it meets
+func CheckContrast(fg, bg interface{}, spec string) (ContrastResult, error) {
+	fgColor, err := ToColor(fg, spec)
+	if err != nil {
+		return ContrastResult{}, err
+	}
+	bgColor, err := ToColor(bg, spec)
+	if err != nil {
+		return ContrastResult{}, err
+	}
+	ratio, err := ContrastRatio(fgColor, bgColor)
+	if err != nil {
+		return ContrastResult{}, err
+	}
+	return ContrastResult{
+		Ratio:    ratio,
+		AA:       ratio >= ContrastAA,
+		AALarge:  ratio >= ContrastAALarge,
+		AAA:      ratio >= ContrastAAA,
+		AAALarge: ratio >= ContrastAAALarge,
+	}, nil
+}
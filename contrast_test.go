@@ -0,0 +1,101 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRelativeLuminance(t *testing.T) {
+	expected := map[Hex]float64{
+		"#000000": 0,
+		"#ffffff": 1,
+		"#ff0000": 0.2126,
+		"#808080": 0.2159,
+	}
+	for hx, luminance := range expected {
+		c, _ := hx.Color()
+		if value := RelativeLuminance(c); !closeTo(value, luminance, 1e-4) {
+			t.Error(hx, "expected", luminance, "got", value)
+		}
+	}
+}
+
+func TestContrastRatio(t *testing.T) {
+	black, _ := Hex("#000000").Color()
+	white, _ := Hex("#ffffff").Color()
+	if value, _ := ContrastRatio(black, white); value != 21 {
+		t.Error("expected 21, got", value)
+	}
+	if value, _ := ContrastRatio(white, black); value != 21 {
+		t.Error("expected 21, got", value)
+	}
+	gray, _ := Hex("#777777").Color()
+	if value, _ := ContrastRatio(gray, white); !closeTo(value, 4.478, 1e-3) {
+		t.Error("expected 4.478, got", value)
+	}
+	if _, err := ContrastRatio(CurrentColor, white); !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for currentcolor, got", err)
+	}
+	if MeetsAA(white, CurrentColor, true) {
+		t.Error("expected currentcolor to meet no level")
+	}
+}
+
+func TestComposite(t *testing.T) {
+	fg, _ := Hex("#ff000080").Color()
+	bg, _ := Hex("#0000ff").Color()
+	value := Composite(fg, bg)
+	if value.Hex() != "#80007f" {
+		t.Error("expected #80007f, got", value.Hex())
+	}
+}
+
+func TestMeetsAA(t *testing.T) {
+	gray, _ := Hex("#767676").Color()
+	lighter, _ := Hex("#777777").Color()
+	white, _ := Hex("#ffffff").Color()
+	if !MeetsAA(gray, white, false) || MeetsAA(lighter, white, false) || !MeetsAA(lighter, white, true) {
+		t.Error("expected #767676 but not #777777 to meet AA on white")
+	}
+	if MeetsAAA(gray, white, false) || !MeetsAAA(gray, white, true) {
+		t.Error("expected #767676 to meet AAA on white for large text only")
+	}
+}
+
+func TestCheckContrast(t *testing.T) {
+	value, _ := CheckContrast("red", "#fff", CSS3)
+	expected := ContrastResult{Ratio: value.Ratio, AALarge: true}
+	if value != expected || !closeTo(value.Ratio, 3.998, 1e-3) {
+		t.Error("expected", expected, "got", value)
+	}
+	translucent, _ := CheckContrast([]int{0, 0, 0, 128}, []string{"100%", "100%", "100%"}, CSS3)
+	gray, _ := CheckContrast("#7f7f7f", "white", CSS3)
+	if translucent != gray {
+		t.Error("expected", gray, "got", translucent)
+	}
+	if _, err := CheckContrast(42, "white", CSS3); err == nil {
+		t.Error("expected an error for an unsupported value")
+	}
+}
+
+func TestToColor(t *testing.T) {
+	values := []interface{}{
+		"navy",
+		"#000080",
+		Hex("#000080"),
+		IntegerRGB{0, 0, 128},
+		[]int{0, 0, 128},
+		[]int{0, 0, 128, 255},
+		[]string{"0%", "0%", "50%"},
+		HSL{240, 100, 25.1},
+	}
+	for _, v := range values {
+		c, err := ToColor(v, CSS3)
+		if err != nil || c.Hex() != "#000080" {
+			t.Error(v, "expected #000080, got", c.Hex(), err)
+		}
+	}
+	if _, err := ToColor(42, CSS3); !errors.Is(err, ErrInvalidValue) || err.Error() != "int is not a type convertible to a color" {
+		t.Error("expected ErrInvalidValue for an int, got", err)
+	}
+}
@@ -0,0 +1,178 @@
+package webcolors
+
+import (
+	"strconv"
+)
+
+// # Color vision deficiency simulation.
+// #################################################################
+//
+// Simulations work on linear-light sRGB. Severity ranges from 0, normal
+// vision, to 1, dichromacy (protanopia, deuteranopia, tritanopia) or full
+// achromatopsia; values in between simulate anomalous trichromacy
+// (protanomaly, deuteranomaly, tritanomaly) by interpolating linearly
+// between the original and the fully simulated color.
+//
+// The Machado 2009 model suits protan and deutan deficiencies, the Brettel
+// 1997 model all three, and is the only one that is accurate for tritan
+// deficiencies; their matrices are those of libDaltonLens.
+//
+// https://daltonlens.org/opensource-cvd-simulation/
+
+// CVDKind a kind of color vision deficiency
+type CVDKind string
+
+const (
+	// NormalVision no color vision deficiency, whose simulation leaves colors unchanged
+	NormalVision CVDKind = "normal"
+	// Protanopia missing or anomalous L (red) cones
+	Protanopia CVDKind = "protanopia"
+	// Deuteranopia missing or anomalous M (green) cones
+	Deuteranopia CVDKind = "deuteranopia"
+	// Tritanopia missing or anomalous S (blue) cones
+	Tritanopia CVDKind = "tritanopia"
+	// Achromatopsia no color vision, only luminance
+	Achromatopsia CVDKind = "achromatopsia"
+)
+
+// CVDKinds the supported kinds of color vision deficiency, besides NormalVision
+var CVDKinds = []CVDKind{Protanopia, Deuteranopia, Tritanopia, Achromatopsia}
+
+// CVDModel a model of color vision deficiency simulation
+type CVDModel string
+
+const (
+	// MachadoModel the model of Machado, Oliveira and Fernandes (2009); for tritan
+	// deficiencies Brettel is used instead
+	MachadoModel CVDModel = "machado"
+	// BrettelModel the model of Brettel, Viénot and Mollon (1997)
+	BrettelModel CVDModel = "brettel"
+)
+
+// machadoMatrices the Machado matrices of dichromacy, in linear sRGB
+var machadoMatrices = map[CVDKind]matrix3{
+	Protanopia: {
+		{0.152286, 1.052583, -0.204868},
+		{0.114503, 0.786281, 0.099216},
+		{-0.003882, -0.048116, 1.051998},
+	},
+	Deuteranopia: {
+		{0.367322, 0.860646, -0.227968},
+		{0.280085, 0.672501, 0.047413},
+		{-0.011820, 0.042940, 0.968881},
+	},
+}
+
+// brettelParams the two half-plane projections of the Brettel model in linear sRGB,
+// and the normal of the plane separating them
+type brettelParams struct {
+	first, second matrix3
+	normal        [3]float64
+}
+
+// brettelModels the Brettel parameters of dichromacy
+var brettelModels = map[CVDKind]brettelParams{
+	Protanopia: {
+		first:  matrix3{{0.14510, 1.20165, -0.34675}, {0.10447, 0.85316, 0.04237}, {0.00429, -0.00603, 1.00174}},
+		second: matrix3{{0.14115, 1.16782, -0.30897}, {0.10495, 0.85730, 0.03776}, {0.00431, -0.00586, 1.00155}},
+		normal: [3]float64{0.00048, 0.00416, -0.00464},
+	},
+	Deuteranopia: {
+		first:  matrix3{{0.36198, 0.86755, -0.22953}, {0.26099, 0.64512, 0.09389}, {-0.01975, 0.02686, 0.99289}},
+		second: matrix3{{0.37009, 0.88540, -0.25549}, {0.25767, 0.63782, 0.10451}, {-0.01950, 0.02741, 0.99209}},
+		normal: [3]float64{-0.00293, -0.00645, 0.00938},
+	},
+	Tritanopia: {
+		first:  matrix3{{1.01354, 0.14268, -0.15622}, {-0.01181, 0.87561, 0.13619}, {0.07707, 0.81208, 0.11085}},
+		second: matrix3{{0.93337, 0.19999, -0.13336}, {0.05809, 0.82565, 0.11626}, {-0.37923, 1.13825, 0.24098}},
+		normal: [3]float64{0.03960, -0.02831, -0.01129},
+	},
+}
+
+// dichromat Internal helper simulating full dichromacy or achromatopsia on linear sRGB channels
+func dichromat(linear [3]float64, kind CVDKind, model CVDModel) ([3]float64, error) {
+	switch model {
+	case "", MachadoModel, BrettelModel:
+	default:
+		return linear, invalidValueError(string(model), "a supported color vision deficiency model")
+	}
+	switch kind {
+	case NormalVision:
+		return linear, nil
+	case Achromatopsia:
+		y := 0.2126*linear[0] + 0.7152*linear[1] + 0.0722*linear[2]
+		return [3]float64{y, y, y}, nil
+	}
+	if model != BrettelModel {
+		if m, ok := machadoMatrices[kind]; ok {
+			return m.mul(linear), nil
+		}
+	}
+	params, ok := brettelModels[kind]
+	if !ok {
+		return linear, invalidValueError(string(kind), "a supported color vision deficiency")
+	}
+	if linear[0]*params.normal[0]+linear[1]*params.normal[1]+linear[2]*params.normal[2] >= 0 {
+		return params.first.mul(linear), nil
+	}
+	return params.second.mul(linear), nil
+}
+
+// SimulateCVDColor Simulate how a color is seen with a color vision deficiency of a
+// severity from 0 to 1 under a model, Machado when empty; the alpha channel is kept
+func SimulateCVDColor(c Color, kind CVDKind, severity float64, model CVDModel) (Color, error) {
+	if !(severity >= 0 && severity <= 1) {
+		return Color{}, invalidValueError(strconv.FormatFloat(severity, 'g', -1, 64), "a severity within 0-1")
+	}
+	linear := srgbToLinear(clampChannels(c.srgb()))
+	simulated, err := dichromat(linear, kind, model)
+	if err != nil {
+		return Color{}, err
+	}
+	for i := range simulated {
+		simulated[i] = linear[i] + (simulated[i]-linear[i])*severity
+	}
+	return Color{Space: SRGB, Channels: clampChannels(linearToSRGB(simulated)), Alpha: c.Alpha}, nil
+}
+
+// SimulateCVD Simulate how a hexadecimal color value is seen with a color vision deficiency
+// of a severity from 0 to 1, returning a normalized hexadecimal value
+func SimulateCVD(hexValue string, kind CVDKind, severity float64) (string, error) {
+	c, err := Hex(hexValue).Color()
+	if err != nil {
+		return "", err
+	}
+	simulated, err := SimulateCVDColor(c, kind, severity, "")
+	if err != nil {
+		return "", err
+	}
+	return string(simulated.Hex()), nil
+}
+
+// SimulateCVDRGB Simulate how an integer rgb triplet is seen with a color vision deficiency
+// of a severity from 0 to 1
+func SimulateCVDRGB(rgbTriplet []int, kind CVDKind, severity float64) ([]int, error) {
+	rgb, err := IntegerRGBFromSlice(rgbTriplet)
+	if err != nil {
+		return []int{}, err
+	}
+	simulated, err := SimulateCVDColor(rgb.Color(), kind, severity, "")
+	if err != nil {
+		return []int{}, err
+	}
+	return simulated.IntegerRGB().Slice(), nil
+}
+
+// SimulateCVDPalette Simulate how every hexadecimal color value of a palette is seen with
+// a color vision deficiency of a severity from 0 to 1
+func SimulateCVDPalette(hexValues []string, kind CVDKind, severity float64) ([]string, error) {
+	simulated := make([]string, len(hexValues))
+	for i, hexValue := range hexValues {
+		hx, err := SimulateCVD(hexValue, kind, severity)
+		if err != nil {
+			return []string{}, err
+		}
+		simulated[i] = hx
+	}
+	return simulated, nil
+}
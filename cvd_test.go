@@ -0,0 +1,84 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSimulateCVD(t *testing.T) {
+	expected := map[CVDKind]string{
+		Protanopia:    "#6d5f00",
+		Deuteranopia:  "#a39000",
+		Tritanopia:    "#ff004e",
+		Achromatopsia: "#7f7f7f",
+	}
+	for kind, hx := range expected {
+		value, err := SimulateCVD("#ff0000", kind, 1)
+		if err != nil || value != hx {
+			t.Error(kind, "expected", hx, "got", value, err)
+		}
+	}
+}
+
+func TestSimulateCVDNeutrals(t *testing.T) {
+	for _, kind := range CVDKinds {
+		for _, hx := range []string{"#ffffff", "#808080", "#000000"} {
+			if value, _ := SimulateCVD(hx, kind, 1); value != hx {
+				t.Error(kind, "expected", hx, "got", value)
+			}
+		}
+	}
+}
+
+func TestSimulateCVDSeverity(t *testing.T) {
+	value, _ := SimulateCVD("#ff0000", Protanopia, 0)
+	if value != "#ff0000" {
+		t.Error("expected #ff0000, got", value)
+	}
+	value, _ = SimulateCVD("#ff0000", Protanopia, 0.5)
+	if value != "#c84400" {
+		t.Error("expected #c84400, got", value)
+	}
+	if value, _ = SimulateCVD("#ff0000", NormalVision, 1); value != "#ff0000" {
+		t.Error("expected #ff0000 under normal vision, got", value)
+	}
+	if _, err := SimulateCVD("#ff0000", Protanopia, 1.5); err == nil {
+		t.Error("expected an error for a severity above 1")
+	}
+}
+
+func TestSimulateCVDModels(t *testing.T) {
+	red := NewColor(SRGB, [3]float64{1, 0, 0}, 0.5)
+	value, _ := SimulateCVDColor(red, Protanopia, 1, BrettelModel)
+	if value.Hex() != "#6a5b0e80" {
+		t.Error("expected #6a5b0e80, got", value.Hex())
+	}
+	for _, kind := range []CVDKind{Protanopia, Tritanopia, Achromatopsia, NormalVision} {
+		if _, err := SimulateCVDColor(red, kind, 1, "coblis"); !errors.Is(err, ErrInvalidValue) {
+			t.Error("expected an invalid value error for an unsupported model, got", err)
+		}
+	}
+	if _, err := SimulateCVDColor(red, "monochromacy", 1, ""); err == nil {
+		t.Error("expected an error for an unsupported kind")
+	}
+}
+
+func TestSimulateCVDRGB(t *testing.T) {
+	value, _ := SimulateCVDRGB([]int{0, 0, 255}, Tritanopia, 1)
+	expected := []int{0, 96, 135}
+	for i := range value {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestSimulateCVDPalette(t *testing.T) {
+	value, _ := SimulateCVDPalette([]string{"#ff0000", "#00ff00", "#0000ff"}, Deuteranopia, 1)
+	expected := []string{"#a39000", "#efd63a", "#003dfb"}
+	for i := range value {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
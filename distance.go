@@ -0,0 +1,235 @@
+package webcolors
+
+import "math"
+
+// # Color distance metrics.
+// #################################################################
+
+// Metric a distance between two colors, ignoring their alpha channels; the smaller, the closer.
+// The zero Metric is CIEDE2000.
+//
+// The metrics of this package measured in CIE Lab or Oklab carry their coordinate form, which
+// lets nearest named color lookups convert the color looked up once and skip the named colors
+// too far from it in lightness.
+type Metric struct {
+	// distance the metric measured on two colors, for the metrics without a coordinate form
+	distance func(a, b Color) float64
+	// space LabSpace or OKLabSpace, the color space coordinates are measured in
+	space Space
+	// coordinates the metric measured on the coordinates of two colors in space
+	coordinates func(a, b [3]float64) float64
+	// lightnessScale when set, returns, given the Lab lightness of a color and the range of
+	// Lab lightness of the colors it is compared with, a factor k such that the distance is
+	// at least k times the lightness difference of the colors
+	lightnessScale func(l, minL, maxL float64) float64
+}
+
+// NewMetric Build a Metric from a function measuring the distance between two colors
+func NewMetric(distance func(a, b Color) float64) Metric {
+	return Metric{distance: distance}
+}
+
+// Distance Measure the distance between two colors
+func (m Metric) Distance(a, b Color) float64 {
+	m = m.orDefault()
+	if m.coordinates != nil {
+		return m.coordinates(a.convert(m.space).Channels, b.convert(m.space).Channels)
+	}
+	return m.distance(a, b)
+}
+
+// orDefault Internal helper returning CIEDE2000 in place of the zero Metric
+func (m Metric) orDefault() Metric {
+	if m.distance == nil && m.coordinates == nil {
+		return CIEDE2000
+	}
+	return m
+}
+
+// EuclideanRGB the Euclidean distance between two colors' integer rgb triplets
+var EuclideanRGB = NewMetric(func(a, b Color) float64 {
+	ca, cb := clampChannels(a.srgb()), clampChannels(b.srgb())
+	dr, dg, db := 255*(ca[0]-cb[0]), 255*(ca[1]-cb[1]), 255*(ca[2]-cb[2])
+	return math.Sqrt(dr*dr + dg*dg + db*db)
+})
+
+// Redmean the "redmean" weighted Euclidean distance between two colors' integer rgb
+// triplets, a cheap approximation of perceptual distance
+//
+// https://www.compuphase.com/cmetric.htm
+var Redmean = NewMetric(func(a, b Color) float64 {
+	ca, cb := clampChannels(a.srgb()), clampChannels(b.srgb())
+	rmean := 255 * (ca[0] + cb[0]) / 2
+	dr, dg, db := 255*(ca[0]-cb[0]), 255*(ca[1]-cb[1]), 255*(ca[2]-cb[2])
+	return math.Sqrt((2+rmean/256)*dr*dr + 4*dg*dg + (2+(255-rmean)/256)*db*db)
+})
+
+// CIE76 the CIE 1976 color difference, the Euclidean distance in CIE Lab
+var CIE76 = Metric{
+	space:          LabSpace,
+	coordinates:    euclidean,
+	lightnessScale: func(l, minL, maxL float64) float64 { return 1 },
+}
+
+// CIE94 the CIE 1994 color difference in CIE Lab, with the weights of graphic arts;
+// a is the reference color, so the metric is not symmetric
+var CIE94 = Metric{
+	space:          LabSpace,
+	coordinates:    func(a, b [3]float64) float64 { return deltaE94(a, b, 1, 0.045, 0.015) },
+	lightnessScale: func(l, minL, maxL float64) float64 { return 1 },
+}
+
+// CIE94Textiles the CIE 1994 color difference in CIE Lab, with the weights of textiles;
+// a is the reference color, so the metric is not symmetric
+var CIE94Textiles = Metric{
+	space:          LabSpace,
+	coordinates:    func(a, b [3]float64) float64 { return deltaE94(a, b, 2, 0.048, 0.014) },
+	lightnessScale: func(l, minL, maxL float64) float64 { return 0.5 },
+}
+
+// CIEDE2000 the CIE 2000 color difference in CIE Lab
+var CIEDE2000 = Metric{
+	space:       LabSpace,
+	coordinates: deltaE2000,
+	// the chroma and hue terms of CIEDE2000, rotation included, are never negative,
+	// so it is at least the lightness difference over the largest weighting SL
+	lightnessScale: func(l, minL, maxL float64) float64 {
+		return 1 / math.Max(lightnessWeight2000((l+minL)/2), lightnessWeight2000((l+maxL)/2))
+	},
+}
+
+// CMC Build the CMC l:c color difference metric of the Colour Measurement Committee,
+// commonly 2:1 for acceptability and 1:1 for perceptibility; a is the reference color,
+// so the metric is not symmetric
+func CMC(l, c float64) Metric {
+	return Metric{
+		space:       LabSpace,
+		coordinates: func(a, b [3]float64) float64 { return deltaECMC(a, b, l, c) },
+	}
+}
+
+// DeltaEOK the Euclidean distance in Oklab, the color difference of CSS gamut mapping;
+// a just noticeable difference is about 0.02
+var DeltaEOK = Metric{
+	space:       OKLabSpace,
+	coordinates: euclidean,
+}
+
+// euclidean Internal helper computing the Euclidean distance of two coordinate triplets
+func euclidean(a, b [3]float64) float64 {
+	d0, d1, d2 := a[0]-b[0], a[1]-b[1], a[2]-b[2]
+	return math.Sqrt(d0*d0 + d1*d1 + d2*d2)
+}
+
+// deltaH2 Internal helper returning the square of the hue difference of two Lab colors
+// given their chroma difference, dropping rounding errors that would make it negative
+func deltaH2(lab1, lab2 [3]float64, dc float64) float64 {
+	da, db := lab1[1]-lab2[1], lab1[2]-lab2[2]
+	return math.Max(da*da+db*db-dc*dc, 0)
+}
+
+// deltaE94 Internal helper computing the CIE 1994 color difference of a reference Lab color
+// and a sample Lab color with the weights kL, K1 and K2
+func deltaE94(lab1, lab2 [3]float64, kl, k1, k2 float64) float64 {
+	c1, c2 := math.Hypot(lab1[1], lab1[2]), math.Hypot(lab2[1], lab2[2])
+	dl, dc := lab1[0]-lab2[0], c1-c2
+	sc, sh := 1+k1*c1, 1+k2*c1
+	return math.Sqrt(math.Pow(dl/kl, 2) + math.Pow(dc/sc, 2) + deltaH2(lab1, lab2, dc)/(sh*sh))
+}
+
+// deltaECMC Internal helper computing the CMC l:c color difference of a reference Lab color and a sample Lab color
+func deltaECMC(lab1, lab2 [3]float64, l, c float64) float64 {
+	c1, c2 := math.Hypot(lab1[1], lab1[2]), math.Hypot(lab2[1], lab2[2])
+	dl, dc := lab1[0]-lab2[0], c1-c2
+	h1 := labHue(lab1[2], lab1[1])
+	sl := 0.511
+	if lab1[0] >= 16 {
+		sl = 0.040975 * lab1[0] / (1 + 0.01765*lab1[0])
+	}
+	sc := 0.0638*c1/(1+0.0131*c1) + 0.638
+	f := math.Sqrt(math.Pow(c1, 4) / (math.Pow(c1, 4) + 1900))
+	t := 0.36 + math.Abs(0.4*math.Cos(radians(h1+35)))
+	if h1 >= 164 && h1 <= 345 {
+		t = 0.56 + math.Abs(0.2*math.Cos(radians(h1+168)))
+	}
+	sh := sc * (f*t + 1 - f)
+	return math.Sqrt(math.Pow(dl/(l*sl), 2) + math.Pow(dc/(c*sc), 2) + deltaH2(lab1, lab2, dc)/(sh*sh))
+}
+
+// degrees Internal helper converting an angle in radians to degrees
+func degrees(rad float64) float64 {
+	return rad * 180 / math.Pi
+}
+
+// radians Internal helper converting an angle in degrees to radians
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
+
+// labHue Internal helper returning the hue angle of a and b in degrees within [0, 360)
+func labHue(b, a float64) float64 {
+	if a == 0 && b == 0 {
+		return 0
+	}
+	return normalizeHue(degrees(math.Atan2(b, a)))
+}
+
+// lightnessWeight2000 Internal helper returning the lightness weighting SL of CIEDE2000
+// at a mean lightness; it grows with the distance of the lightness to 50
+func lightnessWeight2000(lbar float64) float64 {
+	return 1 + 0.015*math.Pow(lbar-50, 2)/math.Sqrt(20+math.Pow(lbar-50, 2))
+}
+
+// deltaE2000 Internal helper computing the CIE 2000 color difference of two Lab colors,
+// following Sharma, Wu and Dalal, "The CIEDE2000 Color-Difference Formula:
+// Implementation Notes, Supplementary Test Data, and Mathematical Observations" (2005)
+func deltaE2000(lab1, lab2 [3]float64) float64 {
+	l1, a1, b1 := lab1[0], lab1[1], lab1[2]
+	l2, a2, b2 := lab2[0], lab2[1], lab2[2]
+
+	cbar := (math.Hypot(a1, b1) + math.Hypot(a2, b2)) / 2
+	cbar7 := math.Pow(cbar, 7)
+	g := 0.5 * (1 - math.Sqrt(cbar7/(cbar7+math.Pow(25, 7))))
+	a1p, a2p := (1+g)*a1, (1+g)*a2
+	c1p, c2p := math.Hypot(a1p, b1), math.Hypot(a2p, b2)
+	h1p, h2p := labHue(b1, a1p), labHue(b2, a2p)
+
+	dlp := l2 - l1
+	dcp := c2p - c1p
+	dhp := 0.0
+	if c1p*c2p != 0 {
+		dhp = h2p - h1p
+		if dhp > 180 {
+			dhp -= 360
+		} else if dhp < -180 {
+			dhp += 360
+		}
+	}
+	dHp := 2 * math.Sqrt(c1p*c2p) * math.Sin(radians(dhp/2))
+
+	lbarp := (l1 + l2) / 2
+	cbarp := (c1p + c2p) / 2
+	hbarp := h1p + h2p
+	if c1p*c2p != 0 {
+		switch {
+		case math.Abs(h1p-h2p) <= 180:
+			hbarp = (h1p + h2p) / 2
+		case h1p+h2p < 360:
+			hbarp = (h1p + h2p + 360) / 2
+		default:
+			hbarp = (h1p + h2p - 360) / 2
+		}
+	}
+
+	t := 1 - 0.17*math.Cos(radians(hbarp-30)) + 0.24*math.Cos(radians(2*hbarp)) +
+		0.32*math.Cos(radians(3*hbarp+6)) - 0.20*math.Cos(radians(4*hbarp-63))
+	dtheta := 30 * math.Exp(-math.Pow((hbarp-275)/25, 2))
+	cbarp7 := math.Pow(cbarp, 7)
+	rc := 2 * math.Sqrt(cbarp7/(cbarp7+math.Pow(25, 7)))
+	sl := lightnessWeight2000(lbarp)
+	sc := 1 + 0.045*cbarp
+	sh := 1 + 0.015*cbarp*t
+	rt := -math.Sin(radians(2*dtheta)) * rc
+
+	return math.Sqrt(math.Pow(dlp/sl, 2) + math.Pow(dcp/sc, 2) + math.Pow(dHp/sh, 2) + rt*(dcp/sc)*(dHp/sh))
+}
@@ -0,0 +1,117 @@
+package webcolors
+
+import "testing"
+
+func TestDeltaE2000Sharma(t *testing.T) {
+	// test data of Sharma, Wu and Dalal (2005), table 1
+	pairs := []struct {
+		lab1, lab2 [3]float64
+		expected   float64
+	}{
+		{[3]float64{50, 2.6772, -79.7751}, [3]float64{50, 0, -82.7485}, 2.0425},
+		{[3]float64{50, 3.1571, -77.2803}, [3]float64{50, 0, -82.7485}, 2.8615},
+		{[3]float64{50, 2.8361, -74.02}, [3]float64{50, 0, -82.7485}, 3.4412},
+		{[3]float64{50, -1.3802, -84.2814}, [3]float64{50, 0, -82.7485}, 1},
+		{[3]float64{50, -1.1848, -84.8006}, [3]float64{50, 0, -82.7485}, 1},
+		{[3]float64{50, -0.9009, -85.5211}, [3]float64{50, 0, -82.7485}, 1},
+		{[3]float64{50, 0, 0}, [3]float64{50, -1, 2}, 2.3669},
+		{[3]float64{50, -1, 2}, [3]float64{50, 0, 0}, 2.3669},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.0009}, 7.1792},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.001}, 7.1792},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.0011}, 7.2195},
+		{[3]float64{50, 2.49, -0.001}, [3]float64{50, -2.49, 0.0012}, 7.2195},
+		{[3]float64{50, -0.001, 2.49}, [3]float64{50, 0.0009, -2.49}, 4.8045},
+		{[3]float64{50, -0.001, 2.49}, [3]float64{50, 0.001, -2.49}, 4.8045},
+		{[3]float64{50, -0.001, 2.49}, [3]float64{50, 0.0011, -2.49}, 4.7461},
+		{[3]float64{50, 2.5, 0}, [3]float64{50, 0, -2.5}, 4.3065},
+		{[3]float64{50, 2.5, 0}, [3]float64{73, 25, -18}, 27.1492},
+		{[3]float64{50, 2.5, 0}, [3]float64{61, -5, 29}, 22.8977},
+		{[3]float64{50, 2.5, 0}, [3]float64{56, -27, -3}, 31.903},
+		{[3]float64{50, 2.5, 0}, [3]float64{58, 24, 15}, 19.4535},
+		{[3]float64{50, 2.5, 0}, [3]float64{50, 3.1736, 0.5854}, 1},
+		{[3]float64{50, 2.5, 0}, [3]float64{50, 3.2972, 0}, 1},
+		{[3]float64{50, 2.5, 0}, [3]float64{50, 1.8634, 0.5757}, 1},
+		{[3]float64{50, 2.5, 0}, [3]float64{50, 3.2592, 0.335}, 1},
+		{[3]float64{60.2574, -34.0099, 36.2677}, [3]float64{60.4626, -34.1751, 39.4387}, 1.2644},
+		{[3]float64{63.0109, -31.0961, -5.8663}, [3]float64{62.8187, -29.7946, -4.0864}, 1.263},
+		{[3]float64{61.2901, 3.7196, -5.3901}, [3]float64{61.4292, 2.248, -4.962}, 1.8731},
+		{[3]float64{35.0831, -44.1164, 3.7933}, [3]float64{35.0232, -40.0716, 1.5901}, 1.8645},
+		{[3]float64{22.7233, 20.0904, -46.694}, [3]float64{23.0331, 14.973, -42.5619}, 2.0373},
+		{[3]float64{36.4612, 47.858, 18.3852}, [3]float64{36.2715, 50.5065, 21.2231}, 1.4146},
+		{[3]float64{90.8027, -2.0831, 1.441}, [3]float64{91.1528, -1.6435, 0.0447}, 1.4441},
+		{[3]float64{90.9257, -0.5406, -0.9208}, [3]float64{88.6381, -0.8985, -0.7239}, 1.5381},
+		{[3]float64{6.7747, -0.2908, -2.4247}, [3]float64{5.8714, -0.0985, -2.2286}, 0.6377},
+		{[3]float64{2.0776, 0.0795, -1.135}, [3]float64{0.9033, -0.0636, -0.5514}, 0.9082},
+	}
+	for i, p := range pairs {
+		if value := deltaE2000(p.lab1, p.lab2); !closeTo(value, p.expected, 1e-4) {
+			t.Error("pair", i+1, "expected", p.expected, "got", value)
+		}
+		a, b := NewColor(LabSpace, p.lab1, 1), NewColor(LabSpace, p.lab2, 1)
+		if value := CIEDE2000.Distance(b, a); !closeTo(value, p.expected, 1e-4) {
+			t.Error("pair", i+1, "expected", p.expected, "got", value)
+		}
+	}
+}
+
+func TestCIE94(t *testing.T) {
+	reference := NewColor(LabSpace, [3]float64{50, 30, 0}, 1)
+	lighter := NewColor(LabSpace, [3]float64{60, 30, 0}, 1)
+	if value := CIE94.Distance(reference, lighter); !closeTo(value, 10, 1e-9) {
+		t.Error("expected 10, got", value)
+	}
+	if value := CIE94Textiles.Distance(reference, lighter); !closeTo(value, 5, 1e-9) {
+		t.Error("expected 5, got", value)
+	}
+	rotated := NewColor(LabSpace, [3]float64{50, 0, 30}, 1)
+	if value := CIE94.Distance(reference, rotated); !closeTo(value, 29.2596, 1e-4) {
+		t.Error("expected 29.2596, got", value)
+	}
+}
+
+func TestCMC(t *testing.T) {
+	reference := NewColor(LabSpace, [3]float64{50, 30, 0}, 1)
+	lighter := NewColor(LabSpace, [3]float64{60, 30, 0}, 1)
+	if value := CMC(2, 1).Distance(reference, lighter); !closeTo(value, 4.5942, 1e-4) {
+		t.Error("expected 4.5942, got", value)
+	}
+	if value := CMC(1, 1).Distance(reference, lighter); !closeTo(value, 9.1885, 1e-4) {
+		t.Error("expected 9.1885, got", value)
+	}
+	rotated := NewColor(LabSpace, [3]float64{50, 0, 30}, 1)
+	if value := CMC(2, 1).Distance(reference, rotated); !closeTo(value, 30.648, 1e-2) {
+		t.Error("expected 30.648, got", value)
+	}
+}
+
+func TestDeltaEOK(t *testing.T) {
+	black, _ := Hex("#000000").Color()
+	white, _ := Hex("#ffffff").Color()
+	if value := DeltaEOK.Distance(black, white); !closeTo(value, 1, 1e-6) {
+		t.Error("expected 1, got", value)
+	}
+	if value := DeltaEOK.Distance(white, white); value != 0 {
+		t.Error("expected 0, got", value)
+	}
+}
+
+func TestMetricsIdentical(t *testing.T) {
+	c, _ := Hex("#336699").Color()
+	for _, metric := range []Metric{EuclideanRGB, Redmean, CIE76, CIE94, CIE94Textiles, CIEDE2000, CMC(2, 1), DeltaEOK} {
+		if value := metric.Distance(c, c); !closeTo(value, 0, 1e-9) {
+			t.Error("expected 0, got", value)
+		}
+	}
+}
+
+func TestNewMetric(t *testing.T) {
+	a, _ := Hex("#336699").Color()
+	b, _ := Hex("#996633").Color()
+	if value, expected := (Metric{}).Distance(a, b), CIEDE2000.Distance(a, b); value != expected {
+		t.Error("expected", expected, "got", value)
+	}
+	name, distance, _ := HexToNearestName("#daa521", "css3", NewMetric(EuclideanRGB.Distance))
+	if name != "goldenrod" || !closeTo(distance, 1, 1e-9) {
+		t.Error("expected goldenrod at 1, got", name, distance)
+	}
+}
@@ -0,0 +1,94 @@
+package webcolors
+
+import (
+	"errors"
+	"strconv"
+)
+
+// # Errors.
+// #################################################################
+//
+// Failed lookups and conversions return a *ColorError wrapping one of the
+// sentinel errors below, so that callers can test for them with errors.Is
+// and retrieve the offending value and specification with errors.As.
+
+var (
+	// ErrUnsupportedSpec the specification is not a registered specification
+	ErrUnsupportedSpec = errors.New("unsupported specification")
+	// ErrUnknownName the color name is not defined in the specification
+	ErrUnknownName = errors.New("unknown color name")
+	// ErrNoNameForValue the color value has no color name defined in the specification
+	ErrNoNameForValue = errors.New("no color name for value")
+	// ErrInvalidHex the value is not a valid hexadecimal color value
+	ErrInvalidHex = errors.New("invalid hexadecimal color value")
+	// ErrInvalidPercent the value is not a valid percentage
+	ErrInvalidPercent = errors.New("invalid percentage")
+	// ErrInvalidValue the value is not one of the values the argument accepts
+	ErrInvalidValue = errors.New("invalid value")
+	// ErrArity the wrong number of values, such as a triplet of 2 values
+	ErrArity = errors.New("wrong number of values")
+	// ErrUnreachableContrast no color of the hue of the foreground reaches the contrast target
+	ErrUnreachableContrast = errors.New("no color of the hue of the foreground reaches the contrast target")
+)
+
+// ColorError an error about a color value or a specification, wrapping one of the sentinel errors
+type ColorError struct {
+	// Err the sentinel error, such as ErrUnknownName
+	Err error
+	// Value the offending value, empty for ErrUnsupportedSpec
+	Value string
+	// Spec the specification of the lookup, if any
+	Spec string
+	// Expected what was expected instead of Value, for ErrInvalidValue and ErrArity,
+	// such as "a supported color space" or "3 values for an rgb triplet", or the
+	// contrast target, for ErrUnreachableContrast
+	Expected string
+}
+
+func (e *ColorError) Error() string {
+	switch e.Err {
+	case ErrUnsupportedSpec:
+		return e.Spec + " is not a supported specification"
+	case ErrUnknownName:
+		if e.Spec == "" {
+			return e.Value + " is not a known color name"
+		}
+		return e.Value + " is not a color name defined in " + e.Spec
+	case ErrNoNameForValue:
+		return e.Value + " has no color name defined in " + e.Spec
+	case ErrInvalidHex:
+		return e.Value + " is not a valid hexadecimal color value"
+	case ErrInvalidPercent:
+		return e.Value + " is not a valid percentage"
+	case ErrInvalidValue:
+		if e.Value == "" {
+			return "expected " + e.Expected
+		}
+		return e.Value + " is not " + e.Expected
+	case ErrArity:
+		return "expected " + e.Expected + ", got " + e.Value
+	case ErrUnreachableContrast:
+		return "no color of the hue of " + e.Value + " reaches " + e.Expected
+	}
+	return e.Value + ": " + e.Err.Error()
+}
+
+// Unwrap Return the sentinel error, for errors.Is and errors.As
+func (e *ColorError) Unwrap() error {
+	return e.Err
+}
+
+// unsupportedSpecError Internal helper building the error of an unsupported specification
+func unsupportedSpecError(spec string) error {
+	return &ColorError{Err: ErrUnsupportedSpec, Spec: spec}
+}
+
+// invalidValueError Internal helper building the error of a value that is not what was expected
+func invalidValueError(value, expected string) error {
+	return &ColorError{Err: ErrInvalidValue, Value: value, Expected: expected}
+}
+
+// arityError Internal helper building the error of a wrong number of values
+func arityError(got int, expected string) error {
+	return &ColorError{Err: ErrArity, Value: strconv.Itoa(got), Expected: expected}
+}
@@ -0,0 +1,135 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrUnsupportedSpec(t *testing.T) {
+	_, err := NameToHex("white", "css5")
+	var colorErr *ColorError
+	if !errors.Is(err, ErrUnsupportedSpec) || !errors.As(err, &colorErr) || colorErr.Spec != "css5" {
+		t.Error("expected ErrUnsupportedSpec for css5, got", err)
+	}
+	if err.Error() != "css5 is not a supported specification" {
+		t.Error("expected css5 is not a supported specification, got", err)
+	}
+	for _, f := range []func() error{
+		func() error { _, err := HexToName("#ffffff", "css5"); return err },
+		func() error { _, err := RGBToName([]int{255, 255, 255}, "css5"); return err },
+		func() error { _, err := ParseColor("white", "css5"); return err },
+		func() error { _, _, err := NearestName(Color{}, "css5", Metric{}); return err },
+	} {
+		if err := f(); !errors.Is(err, ErrUnsupportedSpec) {
+			t.Error("expected ErrUnsupportedSpec, got", err)
+		}
+	}
+}
+
+func TestErrUnknownName(t *testing.T) {
+	_, err := NameToRGB("octarine", CSS3)
+	var colorErr *ColorError
+	if !errors.As(err, &colorErr) || colorErr.Err != ErrUnknownName || colorErr.Value != "octarine" || colorErr.Spec != CSS3 {
+		t.Error("expected ErrUnknownName for octarine in css3, got", err)
+	}
+	if err.Error() != "octarine is not a color name defined in css3" {
+		t.Error("expected octarine is not a color name defined in css3, got", err)
+	}
+	_, err = ParseColor("rgb(from octarine r g b)", CSS3)
+	var syntaxErr *SyntaxError
+	if !errors.Is(err, ErrUnknownName) || !errors.As(err, &syntaxErr) || syntaxErr.Offset != 9 {
+		t.Error("expected a syntax error wrapping ErrUnknownName, got", err)
+	}
+	_, err = SystemColorToHex("octarine", LightScheme)
+	if !errors.Is(err, ErrUnknownName) {
+		t.Error("expected ErrUnknownName, got", err)
+	}
+}
+
+func TestErrNoNameForValue(t *testing.T) {
+	_, err := HexToName("#123456", CSS3)
+	var colorErr *ColorError
+	if !errors.As(err, &colorErr) || colorErr.Err != ErrNoNameForValue || colorErr.Value != "#123456" {
+		t.Error("expected ErrNoNameForValue for #123456, got", err)
+	}
+	if err.Error() != "#123456 has no color name defined in css3" {
+		t.Error("expected #123456 has no color name defined in css3, got", err)
+	}
+	_, err = Format(NewColor(SRGB, [3]float64{1, 0, 0}, 0.5), NotationName, FormatOptions{})
+	if !errors.Is(err, ErrNoNameForValue) {
+		t.Error("expected ErrNoNameForValue, got", err)
+	}
+}
+
+func TestErrInvalidHex(t *testing.T) {
+	_, err := Hex("#12345").Color()
+	var colorErr *ColorError
+	if !errors.As(err, &colorErr) || colorErr.Err != ErrInvalidHex || colorErr.Value != "#12345" {
+		t.Error("expected ErrInvalidHex for #12345, got", err)
+	}
+	for _, value := range []string{"#12", "#1234567", "#ggg", "#"} {
+		_, err := ParseColor(value, CSS3)
+		var syntaxErr *SyntaxError
+		if !errors.Is(err, ErrInvalidHex) || !errors.As(err, &syntaxErr) {
+			t.Error("expected a *SyntaxError wrapping ErrInvalidHex for", value, "got", err)
+		}
+	}
+}
+
+func TestErrInvalidPercent(t *testing.T) {
+	for _, f := range []func() error{
+		func() error { _, err := NormalizePercentTriplet([]string{"10%", "abc%", "0%"}); return err },
+		func() error { _, err := RGBPercentToHex([]string{"10%", "abc%", "0%"}); return err },
+		func() error { _, err := PercentRGBFromSlice([]string{"10%", "abc%", "0%"}); return err },
+		func() error { _, err := HSLToHex([]string{"0", "abc%", "50%"}); return err },
+	} {
+		var colorErr *ColorError
+		if err := f(); !errors.As(err, &colorErr) || colorErr.Err != ErrInvalidPercent || colorErr.Value != "abc%" {
+			t.Error("expected ErrInvalidPercent for abc%, got", err)
+		}
+	}
+}
+
+func TestErrInvalidValue(t *testing.T) {
+	_, err := Color{Space: SRGB, Alpha: 1}.Convert("cmyk")
+	var colorErr *ColorError
+	if !errors.As(err, &colorErr) || colorErr.Err != ErrInvalidValue || colorErr.Value != "cmyk" {
+		t.Error("expected ErrInvalidValue for cmyk, got", err)
+	}
+	if err.Error() != "cmyk is not a supported color space" {
+		t.Error("expected cmyk is not a supported color space, got", err)
+	}
+	for _, f := range []func() error{
+		func() error { _, err := ParseHue("12px"); return err },
+		func() error { _, err := ParseHue("12!"); return err },
+		func() error { _, err := ParseAlpha("50%%"); return err },
+		func() error { _, err := Mix(Color{}, Color{}, 150, SRGB, ""); return err },
+		func() error { _, err := SimulateCVD("#ff0000", "tetrachromacy", 1); return err },
+		func() error { return RegisterSpecification(Specification{}) },
+		func() error { return RegisterSpecification(Specification{Name: CSS3}) },
+	} {
+		if err := f(); !errors.Is(err, ErrInvalidValue) {
+			t.Error("expected ErrInvalidValue, got", err)
+		}
+	}
+}
+
+func TestErrArity(t *testing.T) {
+	_, err := IntegerRGBFromSlice([]int{1, 2})
+	var colorErr *ColorError
+	if !errors.As(err, &colorErr) || colorErr.Err != ErrArity || colorErr.Value != "2" {
+		t.Error("expected ErrArity for 2 values, got", err)
+	}
+	if err.Error() != "expected 3 values for an integer rgb triplet, got 2" {
+		t.Error("expected expected 3 values for an integer rgb triplet, got 2, got", err)
+	}
+	for _, f := range []func() error{
+		func() error { _, err := RGBAToRGBAPercent([]int{1, 2, 3}); return err },
+		func() error { _, err := HSLToRGB([]string{"0", "50%"}); return err },
+		func() error { _, err := NormalizeIntegerTripletGamut([]int{1}, GamutClip); return err },
+	} {
+		if err := f(); !errors.Is(err, ErrArity) {
+			t.Error("expected ErrArity, got", err)
+		}
+	}
+}
@@ -0,0 +1,291 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// # Serialization of colors to CSS.
+// #################################################################
+
+// Notation a CSS notation a Color can be serialized to
+type Notation string
+
+const (
+	// NotationHex #rrggbb, or #rrggbbaa for translucent colors
+	NotationHex Notation = "hex"
+	// NotationRGB legacy comma-separated rgb() and rgba()
+	NotationRGB Notation = "rgb"
+	// NotationRGBModern space-separated rgb() with an optional / alpha
+	NotationRGBModern Notation = "rgb-modern"
+	// NotationHSL legacy comma-separated hsl() and hsla()
+	NotationHSL Notation = "hsl"
+	// NotationHWB hwb()
+	NotationHWB Notation = "hwb"
+	// NotationName the color name, when one exists
+	NotationName Notation = "name"
+	// NotationLab lab()
+	NotationLab Notation = "lab"
+	// NotationLCH lch()
+	NotationLCH Notation = "lch"
+	// NotationOKLab oklab()
+	NotationOKLab Notation = "oklab"
+	// NotationOKLCH oklch()
+	NotationOKLCH Notation = "oklch"
+	// NotationColor color(), in one of the PredefinedSpaces
+	NotationColor Notation = "color"
+)
+
+// srgbNotations the notations serializing sRGB channels
+var srgbNotations = map[Notation]bool{
+	NotationHex:       true,
+	NotationRGB:       true,
+	NotationRGBModern: true,
+	NotationHSL:       true,
+	NotationHWB:       true,
+	NotationName:      true,
+}
+
+// FormatOptions options controlling Format
+type FormatOptions struct {
+	// Precision the number of decimal places kept for the channels of rgb(), hsl()
+	// and hwb(), such as Precision(2). When nil, rgb channels are rounded to integers,
+	// hsl() and hwb() values to integers, lab() and lch() values to 2 decimal places
+	// and oklab(), oklch() and color() values to 4. Alpha is always serialized as
+	// CSSOM does, to the shortest of 2 or 3 decimal places that preserves its 8-bit value.
+	Precision *int
+	// Uppercase serialize hexadecimal digits in upper case, e.g. "#FF0000"; function
+	// names, color names and keywords stay in lower case
+	Uppercase bool
+	// ShortHex use the 3 or 4 digit hexadecimal form when it is lossless
+	ShortHex bool
+	// Percent use percentages for the channels of rgb()
+	Percent bool
+	// Spec the specification used for NotationName, CSS3 when empty
+	Spec string
+	// ColorSpace the predefined color space used for NotationColor; when empty,
+	// the space of the color if it is one of the PredefinedSpaces, SRGB otherwise
+	ColorSpace Space
+	// Gamut how colors outside the sRGB gamut are brought into it for the hex,
+	// rgb(), hsl(), hwb() and name notations; GamutClip when empty
+	Gamut GamutMode
+}
+
+// Precision Return a Precision of the given number of decimal places for FormatOptions;
+// negative numbers are treated as 0
+func Precision(places int) *int {
+	if places < 0 {
+		places = 0
+	}
+	return &places
+}
+
+// precision Internal helper returning the number of decimal places of the options,
+// or the given default when Precision is nil
+func (opts FormatOptions) precision(defaultPlaces int) int {
+	if opts.Precision == nil {
+		return defaultPlaces
+	}
+	if *opts.Precision < 0 {
+		return 0
+	}
+	return *opts.Precision
+}
+
+// Format Serialize a color to a CSS <color> value in the given notation,
+// following the CSSOM serialization rules
+//
+// https://drafts.csswg.org/cssom/#serializing-css-values
+func Format(c Color, notation Notation, opts FormatOptions) (string, error) {
+	var s string
+	var err error
+	if c.IsCurrentColor() {
+		s = "currentcolor"
+	} else {
+		if srgbNotations[notation] {
+			if c, err = c.ToGamut(SRGB, opts.Gamut); err != nil {
+				return "", err
+			}
+		}
+		switch notation {
+		case NotationHex:
+			s = formatHex(c, opts)
+		case NotationRGB:
+			s = formatRGB(c, opts, false)
+		case NotationRGBModern:
+			s = formatRGB(c, opts, true)
+		case NotationHSL:
+			s = formatHSL(c, opts)
+		case NotationHWB:
+			s = formatHWB(c, opts)
+		case NotationName:
+			s, err = formatName(c, opts)
+		case NotationLab, NotationLCH, NotationOKLab, NotationOKLCH:
+			s = formatLab(c, notation, opts)
+		case NotationColor:
+			s, err = formatPredefined(c, opts)
+		default:
+			return "", invalidValueError(string(notation), "a supported notation")
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+	return s, nil
+}
+
+// formatNumber Internal helper rounding a value to the given number of decimal places
+// and returning its shortest representation
+func formatNumber(value float64, precision int) string {
+	scale := math.Pow(10, float64(precision))
+	value = math.Round(value*scale) / scale
+	if value == 0 {
+		// avoid "-0"
+		value = 0
+	}
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// formatAlpha Internal helper serializing an alpha value
+func formatAlpha(alpha float64) string {
+	alpha = clampUnit(alpha)
+	b := math.Round(alpha * 255)
+	rounded := math.Round(b/255*100) / 100
+	if math.Round(rounded*255) == b {
+		return formatNumber(rounded, 2)
+	}
+	return formatNumber(b/255, 3)
+}
+
+// formatHex Internal helper serializing a color to hexadecimal
+func formatHex(c Color, opts FormatOptions) string {
+	rgb := c.IntegerRGB()
+	values := []int{rgb.R, rgb.G, rgb.B}
+	if a := unitToInteger(c.Alpha); a != 255 {
+		values = append(values, a)
+	}
+	short := opts.ShortHex
+	for _, v := range values {
+		if v>>4 != v&0xf {
+			short = false
+		}
+	}
+	s := "#"
+	for _, v := range values {
+		digits := strconv.FormatInt(int64(v)|0x100, 16)[1:]
+		if short {
+			digits = digits[:1]
+		}
+		s += digits
+	}
+	if opts.Uppercase {
+		return strings.ToUpper(s)
+	}
+	return s
+}
+
+// formatRGB Internal helper serializing a color to rgb() or rgba()
+func formatRGB(c Color, opts FormatOptions, modern bool) string {
+	ch := clampChannels(c.srgb())
+	values := make([]string, 3)
+	for i := range ch {
+		switch {
+		case opts.Percent:
+			values[i] = formatNumber(ch[i]*100, opts.precision(0)) + "%"
+		case opts.Precision != nil:
+			values[i] = formatNumber(ch[i]*255, opts.precision(0))
+		default:
+			values[i] = strconv.Itoa(unitToInteger(ch[i]))
+		}
+	}
+	return formatFunction("rgb", values, c.Alpha, modern)
+}
+
+// formatHSL Internal helper serializing a color to hsl() or hsla()
+func formatHSL(c Color, opts FormatOptions) string {
+	hsl := c.HSL()
+	values := []string{
+		formatNumber(hsl.H, opts.precision(0)),
+		formatNumber(hsl.S, opts.precision(0)) + "%",
+		formatNumber(hsl.L, opts.precision(0)) + "%",
+	}
+	return formatFunction("hsl", values, c.Alpha, false)
+}
+
+// formatHWB Internal helper serializing a color to hwb()
+func formatHWB(c Color, opts FormatOptions) string {
+	hwb := c.HWB()
+	values := []string{
+		formatNumber(hwb.H, opts.precision(0)),
+		formatNumber(hwb.W, opts.precision(0)) + "%",
+		formatNumber(hwb.B, opts.precision(0)) + "%",
+	}
+	return formatFunction("hwb", values, c.Alpha, true)
+}
+
+// formatLab Internal helper serializing a color to lab(), lch(), oklab() or oklch()
+func formatLab(c Color, notation Notation, opts FormatOptions) string {
+	precision := opts.precision(2)
+	if opts.Precision == nil && (notation == NotationOKLab || notation == NotationOKLCH) {
+		precision = 4
+	}
+	spaces := map[Notation]Space{
+		NotationLab:   LabSpace,
+		NotationLCH:   LCHSpace,
+		NotationOKLab: OKLabSpace,
+		NotationOKLCH: OKLCHSpace,
+	}
+	ch := c.convert(spaces[notation]).Channels
+	values := []string{formatNumber(ch[0], precision), formatNumber(ch[1], precision), formatNumber(ch[2], precision)}
+	return formatFunction(string(notation), values, c.Alpha, true)
+}
+
+// formatPredefined Internal helper serializing a color to color()
+func formatPredefined(c Color, opts FormatOptions) (string, error) {
+	space := opts.ColorSpace
+	if space == "" {
+		space = SRGB
+		if isPredefinedSpace(c.Space) {
+			space = c.Space
+		}
+	}
+	if !isPredefinedSpace(space) {
+		return "", invalidValueError(string(space), "a predefined color space")
+	}
+	precision := opts.precision(4)
+	ch := c.convert(space).Channels
+	values := []string{formatNumber(ch[0], precision), formatNumber(ch[1], precision), formatNumber(ch[2], precision)}
+	return formatFunction("color", append([]string{string(space)}, values...), c.Alpha, true), nil
+}
+
+// formatFunction Internal helper serializing a color function, naming the legacy
+// comma-separated form after its alpha variant (rgba, hsla) when the color is translucent
+func formatFunction(name string, values []string, alpha float64, modern bool) string {
+	opaque := unitToInteger(alpha) == 255
+	if modern {
+		if !opaque {
+			return name + "(" + strings.Join(values, " ") + " / " + formatAlpha(alpha) + ")"
+		}
+		return name + "(" + strings.Join(values, " ") + ")"
+	}
+	if !opaque {
+		return name + "a(" + strings.Join(values, ", ") + ", " + formatAlpha(alpha) + ")"
+	}
+	return name + "(" + strings.Join(values, ", ") + ")"
+}
+
+// formatName Internal helper serializing a color to its name
+func formatName(c Color, opts FormatOptions) (string, error) {
+	spec := opts.Spec
+	if spec == "" {
+		spec = CSS3
+	}
+	if unitToInteger(c.Alpha) != 255 {
+		if unitToInteger(c.Alpha) == 0 && specHasColorKeywords(spec) {
+			return "transparent", nil
+		}
+		return "", &ColorError{Err: ErrNoNameForValue, Value: string(c.Hex()), Spec: spec}
+	}
+	return c.Name(spec)
+}
@@ -0,0 +1,64 @@
+package webcolors
+
+import "testing"
+
+func TestFormat(t *testing.T) {
+	goldenrod := IntegerRGB{218, 165, 32}.Color()
+	translucent := Color{Space: SRGB, Channels: [3]float64{1, 0, 0}, Alpha: 0.5}
+	cases := []struct {
+		c        Color
+		notation Notation
+		opts     FormatOptions
+		expected string
+	}{
+		{goldenrod, NotationHex, FormatOptions{}, "#daa520"},
+		{goldenrod, NotationHex, FormatOptions{Uppercase: true}, "#DAA520"},
+		{goldenrod, NotationRGB, FormatOptions{Uppercase: true}, "rgb(218, 165, 32)"},
+		{goldenrod, NotationName, FormatOptions{Uppercase: true}, "goldenrod"},
+		{IntegerRGB{255, 0, 0}.Color(), NotationHex, FormatOptions{ShortHex: true}, "#f00"},
+		{translucent, NotationHex, FormatOptions{}, "#ff000080"},
+		{goldenrod, NotationRGB, FormatOptions{}, "rgb(218, 165, 32)"},
+		{translucent, NotationRGB, FormatOptions{}, "rgba(255, 0, 0, 0.5)"},
+		{translucent, NotationRGBModern, FormatOptions{}, "rgb(255 0 0 / 0.5)"},
+		{goldenrod, NotationLab, FormatOptions{Precision: Precision(0)}, "lab(71 12 69)"},
+		{translucent, NotationRGB, FormatOptions{Precision: Precision(0)}, "rgba(255, 0, 0, 0.5)"},
+		{Color{Space: SRGB, Channels: [3]float64{1, 0, 0}, Alpha: 0.96}, NotationRGBModern, FormatOptions{Precision: Precision(1)}, "rgb(255 0 0 / 0.96)"},
+		{Color{Space: SRGB, Channels: [3]float64{1, 0, 0}, Alpha: 0.999}, NotationRGBModern, FormatOptions{}, "rgb(255 0 0)"},
+		{goldenrod, NotationRGB, FormatOptions{Percent: true, Precision: Precision(2)}, "rgb(85.49%, 64.71%, 12.55%)"},
+		{goldenrod, NotationHSL, FormatOptions{}, "hsl(43, 74%, 49%)"},
+		{goldenrod, NotationHWB, FormatOptions{}, "hwb(43 13% 15%)"},
+		{goldenrod, NotationName, FormatOptions{}, "goldenrod"},
+		{Color{Space: SRGB}, NotationName, FormatOptions{}, "transparent"},
+		{CurrentColor, NotationRGB, FormatOptions{}, "currentcolor"},
+	}
+	for _, tc := range cases {
+		value, err := Format(tc.c, tc.notation, tc.opts)
+		if err != nil {
+			t.Error(tc.expected, "unexpected error", err)
+		}
+		if value != tc.expected {
+			t.Error("expected", tc.expected, "got", value)
+		}
+	}
+}
+
+func TestFormatAlpha(t *testing.T) {
+	value := formatAlpha(0.2)
+	if value != "0.2" {
+		t.Error("expected 0.2, got", value)
+	}
+	value = formatAlpha(1.0 / 255)
+	if value != "0.004" {
+		t.Error("expected 0.004, got", value)
+	}
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	for _, notation := range []Notation{NotationHex, NotationRGB, NotationRGBModern, NotationHSL, NotationHWB} {
+		s, _ := Format(IntegerRGB{0, 0, 128}.Color(), notation, FormatOptions{Precision: Precision(3)})
+		value, err := ParseColor(s, CSS3)
+		if err != nil || value.Hex() != "#000080" {
+			t.Error(s, "expected #000080, got", value.Hex(), err)
+		}
+	}
+}
@@ -0,0 +1,161 @@
+package webcolors
+
+import (
+	"math"
+)
+
+// # Gamut mapping.
+// #################################################################
+//
+// Colors given in wide-gamut spaces such as display-p3, or in lab(), lch(),
+// oklab() and oklch(), may lie outside the gamut of sRGB. Clipping their
+// channels to the range 0-1 is cheap but shifts their hue and lightness;
+// the gamut mapping algorithm of CSS Color Level 4 instead reduces their
+// OKLCH chroma until they are within a just noticeable difference of the
+// gamut, keeping their hue and lightness.
+//
+// https://www.w3.org/TR/css-color-4/#gamut-mapping
+
+// GamutMode selects how colors outside a gamut are brought into it
+type GamutMode string
+
+const (
+	// GamutClip clamp each channel to the range of the gamut
+	GamutClip GamutMode = "clip"
+	// GamutMap reduce the OKLCH chroma of the color, as CSS Color Level 4 does
+	GamutMap GamutMode = "map"
+)
+
+// Constants of the CSS gamut mapping algorithm
+const (
+	// gamutJND the just noticeable difference, in deltaEOK
+	gamutJND = 0.02
+	// gamutEpsilon the chroma precision of the binary search
+	gamutEpsilon = 0.0001
+	// gamutTolerance the tolerance of the gamut bounds, absorbing rounding errors of the conversions
+	gamutTolerance = 1e-6
+)
+
+// boundedSpaces the color spaces having a gamut, that is the RGB spaces
+var boundedSpaces = []Space{SRGB, SRGBLinear, DisplayP3, A98RGB, ProPhotoRGB, Rec2020}
+
+// checkBoundedSpace Internal helper checking that a color space has a gamut
+func checkBoundedSpace(space Space) error {
+	for _, s := range boundedSpaces {
+		if s == normalizedSpace(space) {
+			return nil
+		}
+	}
+	return invalidValueError(string(space), "a color space with a gamut")
+}
+
+// InGamut Report whether the color is within the gamut of an RGB color space;
+// colors of unbounded spaces such as lab are always within their gamut
+func (c Color) InGamut(space Space) bool {
+	if checkBoundedSpace(space) != nil {
+		return true
+	}
+	return channelsInGamut(c.convert(space).Channels)
+}
+
+// channelsInGamut Internal helper reporting whether RGB channels are within the range 0-1 inclusive
+func channelsInGamut(channels [3]float64) bool {
+	for _, v := range channels {
+		if v < -gamutTolerance || v > 1+gamutTolerance || math.IsNaN(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// ToGamut Convert the color to an RGB color space, bringing it within its gamut
+// with the given mode; the empty mode clips, like NormalizeIntegerTriplet
+func (c Color) ToGamut(space Space, mode GamutMode) (Color, error) {
+	if err := checkBoundedSpace(space); err != nil {
+		return Color{}, err
+	}
+	converted, err := c.Convert(space)
+	if err != nil {
+		return Color{}, err
+	}
+	switch mode {
+	case "", GamutClip:
+		converted.Channels = clampChannels(converted.Channels)
+		return converted, nil
+	case GamutMap:
+		converted.Channels = gamutMap(converted, normalizedSpace(space))
+		return converted, nil
+	}
+	return Color{}, invalidValueError(string(mode), "a supported gamut mode")
+}
+
+// gamutMap Internal helper implementing the CSS gamut mapping algorithm, returning
+// the channels of the mapped color in the destination space
+func gamutMap(c Color, space Space) [3]float64 {
+	origin := c.convert(OKLCHSpace).Channels
+	if origin[0] >= 1 {
+		return Color{Space: OKLabSpace, Channels: [3]float64{1, 0, 0}}.convert(space).Channels
+	}
+	if origin[0] <= 0 {
+		return Color{Space: OKLabSpace}.convert(space).Channels
+	}
+	if channelsInGamut(c.Channels) {
+		return clampChannels(c.Channels)
+	}
+	toSpace := func(lch [3]float64) [3]float64 {
+		return Color{Space: OKLCHSpace, Channels: lch}.convert(space).Channels
+	}
+	current := origin
+	clipped := clampChannels(toSpace(current))
+	if DeltaEOK.Distance(Color{Space: space, Channels: clipped}, Color{Space: OKLCHSpace, Channels: current}) < gamutJND {
+		return clipped
+	}
+	low, high := 0.0, origin[1]
+	lowInGamut := true
+	for high-low > gamutEpsilon {
+		current[1] = (low + high) / 2
+		if lowInGamut && channelsInGamut(toSpace(current)) {
+			low = current[1]
+			continue
+		}
+		clipped = clampChannels(toSpace(current))
+		e := DeltaEOK.Distance(Color{Space: space, Channels: clipped}, Color{Space: OKLCHSpace, Channels: current})
+		if e < gamutJND {
+			if gamutJND-e < gamutEpsilon {
+				return clipped
+			}
+			lowInGamut = false
+			low = current[1]
+		} else {
+			high = current[1]
+		}
+	}
+	return clipped
+}
+
+// ColorToHex Convert a color to a normalized hexadecimal value, of 8 digits when
+// the color is translucent, bringing it within the sRGB gamut with the given mode
+func ColorToHex(c Color, mode GamutMode) (string, error) {
+	mapped, err := c.ToGamut(SRGB, mode)
+	if err != nil {
+		return "", err
+	}
+	return string(mapped.Hex()), nil
+}
+
+// NormalizeIntegerTripletGamut Normalize an integer rgb triplet so that all values are
+// within the range 0-255 inclusive, bringing it within the sRGB gamut with the given mode
+func NormalizeIntegerTripletGamut(rgbTriplet []int, mode GamutMode) ([]int, error) {
+	if len(rgbTriplet) != 3 {
+		return []int{}, arityError(len(rgbTriplet), "3 values for an integer rgb triplet")
+	}
+	c := Color{Space: SRGB, Alpha: 1}
+	for i, v := range rgbTriplet {
+		c.Channels[i] = float64(v) / 255
+	}
+	mapped, err := c.ToGamut(SRGB, mode)
+	if err != nil {
+		return []int{}, err
+	}
+	return mapped.IntegerRGB().Slice(), nil
+}
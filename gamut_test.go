@@ -0,0 +1,78 @@
+package webcolors
+
+import "testing"
+
+func TestInGamut(t *testing.T) {
+	value, _ := ParseColor("color(display-p3 1 0 0)", CSS3)
+	if value.InGamut(SRGB) || !value.InGamut(DisplayP3) {
+		t.Error("expected display-p3 red outside sRGB and inside display-p3")
+	}
+	value, _ = ParseColor("lab(100 0 0)", CSS3)
+	if !value.InGamut(SRGB) {
+		t.Error("expected lab(100 0 0) inside sRGB")
+	}
+}
+
+func TestToGamut(t *testing.T) {
+	value, _ := ParseColor("oklch(0.7 0.4 30)", CSS3)
+	mapped, _ := value.ToGamut(SRGB, GamutMap)
+	if mapped.Space != SRGB || !mapped.InGamut(SRGB) {
+		t.Error("expected an sRGB color inside the gamut, got", mapped)
+	}
+	if lch := mapped.OKLCH(); !closeTo(lch.H, 30, 0.5) || lch.C >= 0.4 {
+		t.Error("expected the hue kept and the chroma reduced, got", lch)
+	}
+	mapped, _ = NewColor(OKLCHSpace, [3]float64{1.2, 0.3, 90}, 1).ToGamut(SRGB, GamutMap)
+	if mapped.Hex() != "#ffffff" {
+		t.Error("expected #ffffff, got", mapped.Hex())
+	}
+	_, err := value.ToGamut(LabSpace, GamutMap)
+	if err == nil {
+		t.Error("expected an error for a space without a gamut")
+	}
+	_, err = value.ToGamut(SRGB, "scale")
+	if err == nil {
+		t.Error("expected an error for an unsupported gamut mode")
+	}
+}
+
+func TestColorToHex(t *testing.T) {
+	value, _ := ParseColor("oklch(0.7 0.4 30)", CSS3)
+	clipped, _ := ColorToHex(value, GamutClip)
+	if clipped != "#ff0000" {
+		t.Error("expected #ff0000, got", clipped)
+	}
+	mapped, _ := ColorToHex(value, GamutMap)
+	if mapped != "#ff5843" {
+		t.Error("expected #ff5843, got", mapped)
+	}
+	inGamut, _ := ColorToHex(NewColor(SRGB, [3]float64{0.2, 0.4, 0.6}, 1), GamutMap)
+	if inGamut != "#336699" {
+		t.Error("expected #336699, got", inGamut)
+	}
+}
+
+func TestNormalizeIntegerTripletGamut(t *testing.T) {
+	value, _ := NormalizeIntegerTripletGamut([]int{270, -20, 128}, GamutClip)
+	expected := []int{255, 0, 128}
+	for i := range value {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+	value, _ = NormalizeIntegerTripletGamut([]int{0, 153, 204}, GamutMap)
+	expected = []int{0, 153, 204}
+	for i := range value {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestFormatGamut(t *testing.T) {
+	value, _ := ParseColor("oklch(0.7 0.4 30)", CSS3)
+	s, _ := Format(value, NotationHex, FormatOptions{Gamut: GamutMap})
+	if s != "#ff5843" {
+		t.Error("expected #ff5843, got", s)
+	}
+}
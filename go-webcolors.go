@@ -9,7 +9,6 @@ package webcolors
 import (
 	"encoding/binary"
 	"encoding/hex"
-	"errors"
 	"math"
 	"regexp"
 	"strconv"
@@ -25,25 +24,17 @@ const (
 	CSS21 = "css21"
 	// CSS3 css3 spec
 	CSS3 = "css3"
+	// CSS4 css4 spec
+	CSS4 = "css4"
 )
 
-// SupportedSpecifications supported specifications
-var SupportedSpecifications = []string{HTML4, CSS2, CSS21, CSS3}
+// SupportedSpecifications the built-in specifications; see Specifications for every registered one
+var SupportedSpecifications = []string{HTML4, CSS2, CSS21, CSS3, CSS4}
 
-// HexColorRegex a regexp for hex colors
-var HexColorRegex = regexp.MustCompile(`^#([a-fA-F0-9]{3}|[a-fA-F0-9]{6})$`)
+// HexColorRegex a regexp for hex colors, with 3 or 6 digits, or 4 or 8 digits when carrying an alpha channel
+var HexColorRegex = regexp.MustCompile(`^#([a-fA-F0-9]{3,4}|[a-fA-F0-9]{6}|[a-fA-F0-9]{8})$`)
 
-// reverseMap Internal helper for generating reverse mappings; given a
-// dictionary, returns a new dictionary with keys and values swapped.
-func reverseMap(m map[string]string) map[string]string {
-	n := make(map[string]string)
-	for k, v := range m {
-		n[v] = k
-	}
-	return n
-}
-
-// HTML4NamesToHex mapping of html4 color names to hex colors
+// html4NamesToHex mapping of html4 color names to hex colors
 //
 // The HTML 4 named colors.
 //
@@ -51,7 +42,7 @@ func reverseMap(m map[string]string) map[string]string {
 // specification:
 //
 // http://www.w3.org/TR/html401/types.html#h-6.5
-var HTML4NamesToHex = map[string]string{
+var html4NamesToHex = map[string]string{
 	"aqua":    "#00ffff",
 	"black":   "#000000",
 	"blue":    "#0000ff",
@@ -71,17 +62,7 @@ var HTML4NamesToHex = map[string]string{
 	"yellow":  "#ffff00",
 }
 
-// CSS2NamesToHex mapping of css2 color names to hex colors
-//
-// CSS 2 used the same list as HTML 4.
-var CSS2NamesToHex = HTML4NamesToHex
-
-// CSS21NamesToHex mapping of css21 color names to hex colors
-//
-// CSS 2.1 added orange.
-var CSS21NamesToHex = make(map[string]string) // initialized in init()
-
-// CSS3NamesToHex mapping of css3 color names to hex colors
+// css3NamesToHex mapping of css3 color names to hex colors
 //
 // The CSS 3/SVG named colors.
 //
@@ -99,7 +80,7 @@ var CSS21NamesToHex = make(map[string]string) // initialized in init()
 // both as RGB triplets and as hexadecimal. Since hex values are more
 // common in real-world HTML and CSS, the mapping below is to hex
 // values instead.
-var CSS3NamesToHex = map[string]string{
+var css3NamesToHex = map[string]string{
 	"aliceblue":            "#f0f8ff",
 	"antiquewhite":         "#faebd7",
 	"aqua":                 "#00ffff",
@@ -240,80 +221,203 @@ var CSS3NamesToHex = map[string]string{
 	"teal":                 "#008080",
 	"thistle":              "#d8bfd8",
 	"tomato":               "#ff6347",
-	"turquoise":            "#40e0d0",
-	"violet":               "#ee82ee",
-	"wheat":                "#f5deb3",
-	"white":                "#ffffff",
-	"whitesmoke":           "#f5f5f5",
-	"yellow":               "#ffff00",
-	"yellowgreen":          "#9acd32",
+	// CSS 3 also defines the transparent keyword, fully transparent black.
+	"transparent": "#00000000",
+	"turquoise":   "#40e0d0",
+	"violet":      "#ee82ee",
+	"wheat":       "#f5deb3",
+	"white":       "#ffffff",
+	"whitesmoke":  "#f5f5f5",
+	"yellow":      "#ffff00",
+	"yellowgreen": "#9acd32",
 }
 
-// # Mappings of Normalized hexadecimal color Values to color Names.
+// # Deprecated views of the color name tables.
 // #################################################################
+//
+// The maps below are copies of the tables of the built-in specifications,
+// made when the package is initialized. Lookups never read them, so
+// modifying them has no effect on lookups, and they are not safe for
+// concurrent writes; use the lookup functions or the read-only accessors
+// of the specification registry instead.
+
+// HTML4NamesToHex mapping of html4 color names to hex colors
+//
+// Deprecated: use NameToHex, RangeNames or CopyNamesToHex.
+var HTML4NamesToHex = make(map[string]string) // initialized in init()
+
+// CSS2NamesToHex mapping of css2 color names to hex colors
+//
+// Deprecated: use NameToHex, RangeNames or CopyNamesToHex.
+var CSS2NamesToHex = make(map[string]string) // initialized in init()
+
+// CSS21NamesToHex mapping of css21 color names to hex colors
+//
+// Deprecated: use NameToHex, RangeNames or CopyNamesToHex.
+var CSS21NamesToHex = make(map[string]string) // initialized in init()
+
+// CSS3NamesToHex mapping of css3 color names to hex colors
+//
+// Deprecated: use NameToHex, RangeNames or CopyNamesToHex.
+var CSS3NamesToHex = make(map[string]string) // initialized in init()
+
+// CSS4NamesToHex mapping of css4 color names to hex colors
+//
+// Deprecated: use NameToHex, RangeNames or CopyNamesToHex.
+var CSS4NamesToHex = make(map[string]string) // initialized in init()
 
 // HTML4HexToNames html4 color map of hex color values to color names
-var HTML4HexToNames = reverseMap(HTML4NamesToHex)
+//
+// Deprecated: use HexToName, RangeHexToNames or CopyHexToNames.
+var HTML4HexToNames = make(map[string]string) // initialized in init()
 
 // CSS2HexToNames css2 color map of hex color values to color names
-var CSS2HexToNames = HTML4HexToNames
+//
+// Deprecated: use HexToName, RangeHexToNames or CopyHexToNames.
+var CSS2HexToNames = make(map[string]string) // initialized in init()
 
 // CSS21HexToNames css21 color map of hex color values to color names
-var CSS21HexToNames = reverseMap(CSS21NamesToHex)
+//
+// Deprecated: use HexToName, RangeHexToNames or CopyHexToNames.
+var CSS21HexToNames = make(map[string]string) // initialized in init()
 
 // CSS3HexToNames css3 color map of hex color values to color names
-var CSS3HexToNames = reverseMap(CSS3NamesToHex)
+//
+// Deprecated: use HexToName, RangeHexToNames or CopyHexToNames.
+var CSS3HexToNames = make(map[string]string) // initialized in init()
+
+// CSS4HexToNames css4 color map of hex color values to color names
+//
+// Deprecated: use HexToName, RangeHexToNames or CopyHexToNames.
+var CSS4HexToNames = make(map[string]string) // initialized in init()
 
 func init() {
-	// copy map
-	for k, v := range HTML4NamesToHex {
-		CSS21NamesToHex[k] = v
-	}
-	// add orange
-	CSS21NamesToHex["orange"] = "#ffa500"
-
-	// CSS3 defines both 'gray' and 'grey', as well as defining either
-	// variant for other related colors like 'darkgray'/'darkgrey'. For a
-	// 'forward' lookup from name to hex, this is straightforward, but a
-	// 'reverse' lookup from hex to name requires picking one spelling.
-	//
-	// The way in which reverseMap() generates the reverse mappings will
-	// pick a spelling based on the ordering of map keys,
-	// here we manually pick a single spelling that will
-	// consistently be returned. Since 'gray' was the only spelling
-	// supported in HTML 4, CSS1, and CSS2, 'gray' and its varients are
-	// chosen.
-	CSS3HexToNames["#a9a9a9"] = "darkgray"
-	CSS3HexToNames["#2f4f4f"] = "darkslategray"
-	CSS3HexToNames["#696969"] = "dimgray"
-	CSS3HexToNames["#808080"] = "gray"
-	CSS3HexToNames["#d3d3d3"] = "lightgray"
-	CSS3HexToNames["#778899"] = "lightslategray"
-	CSS3HexToNames["#708090"] = "slategray"
+	builtins := []Specification{
+		{Name: HTML4, NamesToHex: html4NamesToHex},
+		// CSS 2 used the same list as HTML 4.
+		{Name: CSS2, Parent: HTML4},
+		// CSS 2.1 added orange.
+		{Name: CSS21, Parent: CSS2, NamesToHex: map[string]string{"orange": "#ffa500"}},
+		{Name: CSS3, NamesToHex: css3NamesToHex, PreferredNames: graySpellings, ColorKeywords: true},
+		// CSS Color Level 4 uses the CSS 3 list and adds rebeccapurple.
+		//
+		// https://www.w3.org/TR/css-color-4/#named-colors
+		//
+		// CSS4 inherits both spellings from CSS3, so the same 'gray'
+		// preference applies to its reverse mappings.
+		{Name: CSS4, Parent: CSS3, NamesToHex: map[string]string{"rebeccapurple": "#663399"}},
+	}
+	for _, spec := range builtins {
+		if err := RegisterSpecification(spec); err != nil {
+			panic(err)
+		}
+	}
+
+	views := map[string][2]map[string]string{
+		HTML4: {HTML4NamesToHex, HTML4HexToNames},
+		CSS2:  {CSS2NamesToHex, CSS2HexToNames},
+		CSS21: {CSS21NamesToHex, CSS21HexToNames},
+		CSS3:  {CSS3NamesToHex, CSS3HexToNames},
+		CSS4:  {CSS4NamesToHex, CSS4HexToNames},
+	}
+	for spec, view := range views {
+		registered, _ := lookupSpec(spec)
+		for k, v := range registered.namesToHex {
+			view[0][k] = v
+		}
+		for k, v := range registered.hexToName {
+			view[1][k] = v
+		}
+	}
+}
+
+// graySpellings the 'gray' spelling of the colors CSS3 defines with both 'gray' and 'grey'.
+//
+// CSS3 defines both 'gray' and 'grey', as well as defining either
+// variant for other related colors like 'darkgray'/'darkgrey'. For a
+// 'forward' lookup from name to hex, this is straightforward, but a
+// 'reverse' lookup from hex to name requires picking one spelling.
+// Since 'gray' was the only spelling supported in HTML 4, CSS1, and
+// CSS2, 'gray' and its varients are chosen.
+var graySpellings = map[string]string{
+	"#a9a9a9": "darkgray",
+	"#2f4f4f": "darkslategray",
+	"#696969": "dimgray",
+	"#808080": "gray",
+	"#d3d3d3": "lightgray",
+	"#778899": "lightslategray",
+	"#708090": "slategray",
 }
 
 // Normalization routines.
 // #################################################################
 
 // NormalizeHex Normalize a hexadecimal color value to 6 digits, lowercase.
+//
+// Values carrying an alpha channel are normalized to 8 digits, unless
+// they are fully opaque, in which case the alpha channel is dropped.
+// Malformed values normalize to the empty string; use ParseHex to get an error instead.
 func NormalizeHex(HexValue string) string {
-	hexDigits := HexColorRegex.FindStringSubmatch(HexValue)[1]
-	if len(hexDigits) == 3 {
+	match := HexColorRegex.FindStringSubmatch(HexValue)
+	if match == nil {
+		return ""
+	}
+	hexDigits := match[1]
+	if len(hexDigits) == 3 || len(hexDigits) == 4 {
 		finalhex := []string{}
 		for i := range hexDigits {
 			finalhex = append(finalhex, strings.Repeat(string(hexDigits[i]), 2))
 		}
-		return "#" + strings.ToLower(strings.Join(finalhex, ""))
+		hexDigits = strings.Join(finalhex, "")
+	}
+	hexDigits = strings.ToLower(hexDigits)
+	if len(hexDigits) == 8 && hexDigits[6:] == "ff" {
+		hexDigits = hexDigits[:6]
+	}
+	return "#" + hexDigits
+}
+
+// ParseHex Validate a hexadecimal color value and normalize it as NormalizeHex does,
+// returning an ErrInvalidHex error for malformed values
+func ParseHex(hexValue string) (string, error) {
+	if err := ValidateHex(hexValue); err != nil {
+		return "", err
 	}
-	return "#" + strings.ToLower(hexDigits)
+	return NormalizeHex(hexValue), nil
+}
+
+// IsHex Report whether a value is a valid hexadecimal color value, with 3, 4, 6 or 8 digits
+func IsHex(value string) bool {
+	return HexColorRegex.MatchString(value)
+}
+
+// ValidateHex Check that a value is a valid hexadecimal color value, returning an ErrInvalidHex error otherwise
+func ValidateHex(value string) error {
+	if !IsHex(value) {
+		return &ColorError{Err: ErrInvalidHex, Value: value}
+	}
+	return nil
 }
 
 // NormalizeIntegerTriplet Normalize an integer rgb triplet so that all values are within the range 0-255 inclusive.
+//
+// Values past the third are dropped; a triplet with fewer than 3 values is normalized as far as it goes.
 func NormalizeIntegerTriplet(RGBTriplet []int) []int {
-	integerTriplet := []int{normalizeIntegerRGB(RGBTriplet[0]), normalizeIntegerRGB(RGBTriplet[1]), normalizeIntegerRGB(RGBTriplet[2])}
+	integerTriplet := []int{}
+	for i := 0; i < 3 && i < len(RGBTriplet); i++ {
+		integerTriplet = append(integerTriplet, normalizeIntegerRGB(RGBTriplet[i]))
+	}
 	return integerTriplet
 }
 
+// checkTriplet Internal helper checking that a triplet has exactly 3 values
+func checkTriplet(length int) error {
+	if length != 3 {
+		return arityError(length, "3 values for an rgb triplet")
+	}
+	return nil
+}
+
 // normalizeIntegerRGB Normalize value for use in an integer rgb triplet
 func normalizeIntegerRGB(value int) int {
 	if value >= 0 && value <= 255 {
@@ -356,7 +460,7 @@ func normalizePercentRGB(value string) (string, error) {
 			}
 		}
 	}
-	return "", err
+	return "", &ColorError{Err: ErrInvalidPercent, Value: value}
 }
 
 // NormalizePercentTriplet Normalize a percentage rgb triplet to that all values are within the range 0%-100% inclusive.
@@ -375,43 +479,22 @@ func NormalizePercentTriplet(rgbTriplet []string) ([]string, error) {
 //# Conversions from color Names to various formats.
 // #################################################################
 
-// contains checks if a array of strings contains a string
-func contains(s []string, e string) bool {
-	for _, output := range s {
-		if output == e {
-			return true
-		}
-	}
-	return false
-}
-
 // NameToHex Convert a color name to a normalized hexadecimal color value
 func NameToHex(name string, spec string) (string, error) {
-	if contains(SupportedSpecifications, spec) == true {
-		normalized := strings.ToLower(name)
-		var hexStr string
-		var ok bool
-		switch spec {
-		case HTML4:
-			hexStr, ok = HTML4NamesToHex[normalized]
-		case CSS2:
-			hexStr, ok = CSS2NamesToHex[normalized]
-		case CSS21:
-			hexStr, ok = CSS21NamesToHex[normalized]
-		case CSS3:
-			hexStr, ok = CSS3NamesToHex[normalized]
-		default:
-			return "", errors.New(spec + "is not output supported Specification for color name lookups")
-		}
-		if !ok {
-			return "", errors.New(name + "has no defined color name in " + spec)
-		}
-		return hexStr, nil
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return "", unsupportedSpecError(spec)
 	}
-	return "", errors.New(spec + "is not output supported Specification for color name lookups")
+	hexStr, ok := registered.namesToHex[strings.ToLower(name)]
+	if !ok {
+		return "", &ColorError{Err: ErrUnknownName, Value: name, Spec: spec}
+	}
+	return hexStr, nil
 }
 
 // NameToRGB Convert a color name to a 3-tuple of integers suitable for use in an rgb triplet specifying that color
+//
+// A triplet has no alpha channel, so translucent colors such as transparent are an error; use NameToRGBA for them.
 func NameToRGB(name string, spec string) ([]int, error) {
 	hx, err := NameToHex(name, spec)
 	if err != nil {
@@ -421,6 +504,8 @@ func NameToRGB(name string, spec string) ([]int, error) {
 }
 
 // NameToRGBPercent Convert a color name to a 3-tuple of percentages suitable for use in an rgb triplet specifying that color
+//
+// A triplet has no alpha channel, so translucent colors such as transparent are an error; use NameToRGBA for them.
 func NameToRGBPercent(name string, spec string) ([]string, error) {
 	rgb, err := NameToRGB(name, spec)
 	if err != nil {
@@ -434,28 +519,19 @@ func NameToRGBPercent(name string, spec string) ([]string, error) {
 
 // HexToName Convert a hexadecimal color value to its corresponding normalized color name, if any such name exists
 func HexToName(hexValue string, spec string) (string, error) {
-	if contains(SupportedSpecifications, spec) == true {
-		normalized := NormalizeHex(hexValue)
-		var name string
-		var ok bool
-		switch spec {
-		case HTML4:
-			name, ok = HTML4HexToNames[normalized]
-		case CSS2:
-			name, ok = CSS2HexToNames[normalized]
-		case CSS21:
-			name, ok = CSS21HexToNames[normalized]
-		case CSS3:
-			name, ok = CSS3HexToNames[normalized]
-		default:
-			return "", errors.New(spec + "is not output supported Specification for color name lookups")
-		}
-		if !ok {
-			return "", errors.New(hexValue + "has no defined color name in " + spec)
-		}
-		return name, nil
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return "", unsupportedSpecError(spec)
 	}
-	return "", errors.New(spec + "is not output supported Specification for color Name lookups")
+	normalized, err := ParseHex(hexValue)
+	if err != nil {
+		return "", err
+	}
+	name, ok := registered.hexToName[normalized]
+	if !ok {
+		return "", &ColorError{Err: ErrNoNameForValue, Value: hexValue, Spec: spec}
+	}
+	return name, nil
 }
 
 // ByteToInt converts a hex bytearray to hex integer
@@ -470,28 +546,38 @@ func ByteToInt(input []byte) int {
 }
 
 // HexToRGB Convert a hexadecimal color value to a 3-tuple of integers suitable for use in an rgb triplet specifying that color
+//
+// A triplet has no alpha channel, so translucent values such as "#ff000080" are an error; use HexToRGBA for them.
 func HexToRGB(hexValue string) ([]int, error) {
-	hexDigits := NormalizeHex(hexValue)
+	hexDigits, err := ParseHex(hexValue)
+	if err != nil {
+		return []int{}, err
+	}
+	if len(hexDigits) > 7 {
+		return []int{}, &ColorError{Err: ErrInvalidValue, Value: hexValue, Expected: "an opaque color"}
+	}
 	rgbTuple := []int{}
 	partialHex1, err := hex.DecodeString(hexDigits[1:3])
 	if err != nil {
-		return rgbTuple, err
+		return rgbTuple, &ColorError{Err: ErrInvalidHex, Value: hexValue}
 	}
 	rgbTuple = append(rgbTuple, ByteToInt(partialHex1))
 	partialHex2, err := hex.DecodeString(hexDigits[3:5])
 	if err != nil {
-		return rgbTuple, err
+		return rgbTuple, &ColorError{Err: ErrInvalidHex, Value: hexValue}
 	}
 	rgbTuple = append(rgbTuple, ByteToInt(partialHex2))
 	partialHex3, err := hex.DecodeString(hexDigits[5:7])
 	if err != nil {
-		return rgbTuple, err
+		return rgbTuple, &ColorError{Err: ErrInvalidHex, Value: hexValue}
 	}
 	rgbTuple = append(rgbTuple, ByteToInt(partialHex3))
 	return rgbTuple, err
 }
 
 // HexToRGBPercent Convert a hexadecimal color value to a 3-tuple of percentages suitable for use in an rgb triplet representing that color
+//
+// A triplet has no alpha channel, so translucent values are an error; use HexToRGBAPercent for them.
 func HexToRGBPercent(hexValue string) ([]string, error) {
 	hx, err := HexToRGB(hexValue)
 	if err != nil {
@@ -505,12 +591,20 @@ func HexToRGBPercent(hexValue string) ([]string, error) {
 
 // RGBToName Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to its corresponding normalized color name, if any such name exists
 func RGBToName(rgbTriplet []int, spec string) (string, error) {
+	if err := checkTriplet(len(rgbTriplet)); err != nil {
+		return "", err
+	}
 	return HexToName(RGBToHex(NormalizeIntegerTriplet(rgbTriplet)), spec)
 }
 
 // RGBToHex Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to a normalized hexadecimal value for that color
+//
+// Triplets with fewer than 3 values convert to the empty string.
 func RGBToHex(rgbTriplet []int) string {
 	integerTriplet := NormalizeIntegerTriplet(rgbTriplet)
+	if len(integerTriplet) < 3 {
+		return ""
+	}
 	hexString := "#"
 	for i := range integerTriplet {
 		byteCoded := make([]byte, 2)
@@ -530,6 +624,9 @@ func RGBToRGBPercent(rgbTriplet []int) ([]string, error) {
 		16:  "6.25%",
 		0:   "0%",
 	}
+	if err := checkTriplet(len(rgbTriplet)); err != nil {
+		return []string{}, err
+	}
 	rgbPercentTriplet := []string{}
 	normalizedTriplet := NormalizeIntegerTriplet(rgbTriplet)
 
@@ -549,6 +646,9 @@ func RGBToRGBPercent(rgbTriplet []int) ([]string, error) {
 
 // RGBPercentToName Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to its corresponding normalized color name, if any such name exists
 func RGBPercentToName(rgbPercentTriplet []string, spec string) (string, error) {
+	if err := checkTriplet(len(rgbPercentTriplet)); err != nil {
+		return "", err
+	}
 	npt, err := NormalizePercentTriplet(rgbPercentTriplet)
 	if err != nil {
 		return "", err
@@ -562,6 +662,9 @@ func RGBPercentToName(rgbPercentTriplet []string, spec string) (string, error) {
 
 // RGBPercentToHex Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to a normalized hexadecimal color value for that color
 func RGBPercentToHex(rgbPercentTriplet []string) (string, error) {
+	if err := checkTriplet(len(rgbPercentTriplet)); err != nil {
+		return "", err
+	}
 	npt, err := NormalizePercentTriplet(rgbPercentTriplet)
 	if err != nil {
 		return "", err
@@ -584,22 +687,30 @@ func percentToInteger(percent string) (int, error) {
 		}
 		return int(math.Ceil(num)), nil
 	}
-	return 0, err
+	return 0, &ColorError{Err: ErrInvalidPercent, Value: percent}
 }
 
 // RGBPercentToRGB Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to a 3-tuple of integers suitable for use in representing that color
 func RGBPercentToRGB(rgbPercentTriplet []string) ([]int, error) {
-	rgbTriplet := []int{}
-	normalizedTriplet, err := NormalizePercentTriplet(rgbPercentTriplet)
+	if err := checkTriplet(len(rgbPercentTriplet)); err != nil {
+		return []int{}, err
+	}
+	return percentsToIntegers(rgbPercentTriplet)
+}
+
+// percentsToIntegers Internal helper converting every percentage it is given to an integer between 0 and 255 inclusive
+func percentsToIntegers(percents []string) ([]int, error) {
+	integers := []int{}
+	normalized, err := NormalizePercentTriplet(percents)
 	if err != nil {
-		return rgbTriplet, err
+		return integers, err
 	}
-	for i := range normalizedTriplet {
-		perI, err := percentToInteger(normalizedTriplet[i])
+	for i := range normalized {
+		perI, err := percentToInteger(normalized[i])
 		if err != nil {
-			return rgbTriplet, err
+			return integers, err
 		}
-		rgbTriplet = append(rgbTriplet, perI)
+		integers = append(integers, perI)
 	}
-	return rgbTriplet, nil
+	return integers, nil
 }
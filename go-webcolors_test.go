@@ -1,6 +1,9 @@
 package webcolors
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestNormalizeHex(t *testing.T) {
 	value := NormalizeHex("#0099CC")
@@ -87,6 +90,18 @@ func TestHexToRGB(t *testing.T) {
 	}
 }
 
+func TestTripletsRejectTranslucent(t *testing.T) {
+	if _, err := NameToRGB("transparent", CSS3); !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for transparent, got", err)
+	}
+	if _, err := HexToRGB("#ff000000"); !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for #ff000000, got", err)
+	}
+	if value, err := HexToRGB("#ff0000ff"); err != nil || len(value) != 3 || value[0] != 255 {
+		t.Error("expected [255 0 0] for an opaque 8 digit value, got", value, err)
+	}
+}
+
 func TestHexToRGBPercent(t *testing.T) {
 	value, _ := HexToRGBPercent("#000080")
 	expected := []string{"0%", "0%", "50%"}
@@ -144,3 +159,121 @@ func TestRGBPercentToRGB(t *testing.T) {
 		}
 	}
 }
+
+func TestRGBPercentToRGBArity(t *testing.T) {
+	for _, triplet := range [][]string{{"50%"}, {"0%", "0%", "0%", "50%"}, {"0%", "0%", "0%", "0%", "0%"}} {
+		if value, err := RGBPercentToRGB(triplet); !errors.Is(err, ErrArity) {
+			t.Error("expected ErrArity for", triplet, "got", value, err)
+		}
+	}
+	if value, err := RGBAPercentToRGBA([]string{"0%", "0%", "50%"}); !errors.Is(err, ErrArity) {
+		t.Error("expected ErrArity for a short quadruplet, got", value, err)
+	}
+}
+
+func TestCSS4NameToHex(t *testing.T) {
+	value, _ := NameToHex("RebeccaPurple", "css4")
+	if value != "#663399" {
+		t.Error("expected #663399, got", value)
+	}
+	if _, err := NameToHex("rebeccapurple", "css3"); err == nil {
+		t.Error("expected an error for rebeccapurple in css3")
+	}
+}
+
+func TestCSS4HexToName(t *testing.T) {
+	value, _ := HexToName("#663399", "css4")
+	if value != "rebeccapurple" {
+		t.Error("expected rebeccapurple, got", value)
+	}
+	value, _ = HexToName("#2f4f4f", "css4")
+	if value != "darkslategray" {
+		t.Error("expected darkslategray, got", value)
+	}
+}
+
+func TestCSS4Keywords(t *testing.T) {
+	value, _ := ParseColor("currentcolor", "css4")
+	if !value.IsCurrentColor() {
+		t.Error("expected currentcolor, got", value)
+	}
+	value, _ = ParseColor("transparent", "css4")
+	if value.Hex() != "#00000000" {
+		t.Error("expected #00000000, got", value.Hex())
+	}
+}
+
+func TestParseHex(t *testing.T) {
+	value, err := ParseHex("#ABC")
+	if err != nil || value != "#aabbcc" {
+		t.Error("expected #aabbcc, got", value, err)
+	}
+	value, err = ParseHexAlpha("#abc")
+	if err != nil || value != "#aabbccff" {
+		t.Error("expected #aabbccff, got", value, err)
+	}
+	for _, s := range []string{"", "red", "#12", "#12345", "#1234567", "#gggggg", "aabbcc", " #aabbcc"} {
+		if _, err := ParseHex(s); !errors.Is(err, ErrInvalidHex) {
+			t.Error("expected ErrInvalidHex for "+s+", got", err)
+		}
+		if IsHex(s) {
+			t.Error("expected " + s + " not to be a hex value")
+		}
+		if NormalizeHex(s) != "" {
+			t.Error("expected an empty normalized value for "+s+", got", NormalizeHex(s))
+		}
+	}
+	if !IsHex("#aabbccdd") || ValidateHex("#AbC") != nil {
+		t.Error("expected #aabbccdd and #AbC to be hex values")
+	}
+}
+
+func TestMalformedInputDoesNotPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != nil {
+			t.Error("expected no panic, got", r)
+		}
+	}()
+	for _, s := range []string{"red", "#12", ""} {
+		if _, err := HexToRGB(s); !errors.Is(err, ErrInvalidHex) {
+			t.Error("expected ErrInvalidHex from HexToRGB for "+s+", got", err)
+		}
+		if _, err := HexToName(s, CSS3); !errors.Is(err, ErrInvalidHex) {
+			t.Error("expected ErrInvalidHex from HexToName for "+s+", got", err)
+		}
+		if _, err := HexToRGBPercent(s); !errors.Is(err, ErrInvalidHex) {
+			t.Error("expected ErrInvalidHex from HexToRGBPercent for "+s+", got", err)
+		}
+		if _, err := HexToRGBA(s); !errors.Is(err, ErrInvalidHex) {
+			t.Error("expected ErrInvalidHex from HexToRGBA for "+s+", got", err)
+		}
+		if _, err := HexToHSL(s); err == nil {
+			t.Error("expected an error from HexToHSL for " + s)
+		}
+		if _, err := HexToHWB(s); err == nil {
+			t.Error("expected an error from HexToHWB for " + s)
+		}
+	}
+	for _, triplet := range [][]int{nil, {1}, {1, 2}} {
+		if _, err := RGBToName(triplet, CSS3); err == nil {
+			t.Error("expected an error from RGBToName for", triplet)
+		}
+		if _, err := RGBToRGBPercent(triplet); err == nil {
+			t.Error("expected an error from RGBToRGBPercent for", triplet)
+		}
+		if value := RGBToHex(triplet); value != "" {
+			t.Error("expected an empty hex value, got", value)
+		}
+		if value := RGBAToHex(triplet); value != "" {
+			t.Error("expected an empty hex value, got", value)
+		}
+		NormalizeIntegerTriplet(triplet)
+		NormalizeIntegerQuadruplet(triplet)
+	}
+	if _, err := RGBPercentToHex([]string{"50%"}); err == nil {
+		t.Error("expected an error from RGBPercentToHex for a single value")
+	}
+	if _, err := RGBPercentToName([]string{"50%", "50%"}, CSS3); err == nil {
+		t.Error("expected an error from RGBPercentToName for two values")
+	}
+}
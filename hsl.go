@@ -0,0 +1,316 @@
+package webcolors
+
+import (
+	"math"
+)
+
+// # HSL color values.
+// #################################################################
+
+// HSLSpace the hsl form of sRGB: a hue angle in degrees, and a saturation and
+// lightness within the range 0-100 inclusive
+const HSLSpace Space = "hsl"
+
+func init() {
+	toBase := func(c [3]float64) [3]float64 {
+		return hslToSRGB(c[0], c[1]/100, c[2]/100)
+	}
+	fromBase := func(c [3]float64) [3]float64 {
+		hue, sat, light := srgbToHSL(c)
+		return [3]float64{hue, sat * 100, light * 100}
+	}
+	spaceConversions[HSLSpace] = spaceConversion{
+		toXYZ:    func(c [3]float64) [3]float64 { return spaceConversions[SRGB].toXYZ(toBase(c)) },
+		fromXYZ:  func(c [3]float64) [3]float64 { return fromBase(spaceConversions[SRGB].fromXYZ(c)) },
+		base:     SRGB,
+		toBase:   toBase,
+		fromBase: fromBase,
+	}
+}
+
+// normalizeHue Internal helper bringing a hue angle in degrees within the range [0, 360)
+func normalizeHue(hue float64) float64 {
+	hue = math.Mod(hue, 360)
+	if hue < 0 {
+		hue += 360
+	}
+	return hue
+}
+
+// hslToSRGB Internal helper converting a hue in degrees and a saturation and lightness
+// within 0-1 to sRGB channels, following the reference algorithm of the CSS Color specification:
+//
+// https://www.w3.org/TR/css-color-4/#hsl-to-rgb
+func hslToSRGB(hue, sat, light float64) [3]float64 {
+	hue = normalizeHue(hue)
+	f := func(n float64) float64 {
+		k := math.Mod(n+hue/30, 12)
+		a := sat * math.Min(light, 1-light)
+		return light - a*math.Max(-1, math.Min(math.Min(k-3, 9-k), 1))
+	}
+	return [3]float64{f(0), f(8), f(4)}
+}
+
+// srgbToHSL Internal helper converting sRGB channels to a hue in degrees and a saturation
+// and lightness within 0-1, following the reference algorithm of the CSS Color specification.
+// Achromatic colors get a hue of 0.
+//
+// https://www.w3.org/TR/css-color-4/#rgb-to-hsl
+func srgbToHSL(rgb [3]float64) (hue, sat, light float64) {
+	red, green, blue := rgb[0], rgb[1], rgb[2]
+	max := math.Max(red, math.Max(green, blue))
+	min := math.Min(red, math.Min(green, blue))
+	light = (min + max) / 2
+	d := max - min
+	if d != 0 {
+		if light != 0 && light != 1 {
+			sat = (max - light) / math.Min(light, 1-light)
+		}
+		switch max {
+		case red:
+			hue = (green - blue) / d
+			if green < blue {
+				hue += 6
+			}
+		case green:
+			hue = (blue-red)/d + 2
+		case blue:
+			hue = (red-green)/d + 4
+		}
+		hue *= 60
+	}
+	// Very out of gamut colors can produce negative saturation
+	if sat < 0 {
+		hue += 180
+		sat = math.Abs(sat)
+	}
+	if hue >= 360 {
+		hue -= 360
+	}
+	return hue, sat, light
+}
+
+// HSL an hsl triplet: a hue angle in degrees, and a saturation and lightness
+// within the range 0-100 inclusive.
+type HSL struct {
+	H, S, L float64
+}
+
+// HSL Convert the color to an hsl triplet, clamping out of range channels
+func (c Color) HSL() HSL {
+	hue, sat, light := srgbToHSL(clampChannels(c.srgb()))
+	return HSL{hue, sat * 100, light * 100}
+}
+
+// Color Convert the triplet to an opaque Color
+func (t HSL) Color() Color {
+	rgb := hslToSRGB(t.H, clampUnit(t.S/100), clampUnit(t.L/100))
+	return Color{Space: SRGB, Channels: clampChannels(rgb), Alpha: 1}
+}
+
+// Strings Convert the triplet to a 3-tuple of strings such as "120", "100%", "50%"
+func (t HSL) Strings() []string {
+	return []string{
+		formatNumber(normalizeHue(t.H), 2),
+		formatNumber(t.S, 2) + "%",
+		formatNumber(t.L, 2) + "%",
+	}
+}
+
+// ParseHue Parse a CSS hue, a number of degrees or an angle such as "120deg",
+// "2.0944rad", "133.33grad" or "0.333turn", returning its value in degrees
+func ParseHue(hue string) (float64, error) {
+	toks, err := tokenize(hue)
+	if err != nil {
+		return 0, invalidValueError(hue, "a valid hue")
+	}
+	if len(toks) == 1 {
+		switch toks[0].kind {
+		case tokNumber:
+			return toks[0].value, nil
+		case tokDimension:
+			if deg, ok := angleToDegrees(toks[0].value, toks[0].text); ok {
+				return deg, nil
+			}
+		}
+	}
+	return 0, invalidValueError(hue, "a valid hue")
+}
+
+// ParseAlpha Parse a CSS <alpha-value>, a number such as "0.5" or a percentage
+// such as "50%", returning its value clamped to 0-1
+func ParseAlpha(alpha string) (float64, error) {
+	toks, err := tokenize(alpha)
+	if err != nil {
+		return 0, invalidValueError(alpha, "a valid alpha value")
+	}
+	if len(toks) == 1 {
+		switch toks[0].kind {
+		case tokNumber:
+			return clampUnit(toks[0].value), nil
+		case tokPercentage:
+			return clampUnit(toks[0].value / 100), nil
+		}
+	}
+	return 0, invalidValueError(alpha, "a valid alpha value")
+}
+
+// parseHSLTriplet Internal helper parsing a 3-tuple of strings suitable for use in an hsl triplet
+func parseHSLTriplet(hslTriplet []string) (HSL, error) {
+	if len(hslTriplet) != 3 {
+		return HSL{}, arityError(len(hslTriplet), "3 values for an hsl triplet")
+	}
+	hue, err := ParseHue(hslTriplet[0])
+	if err != nil {
+		return HSL{}, err
+	}
+	sat, err := parsePercent(hslTriplet[1])
+	if err != nil {
+		return HSL{}, err
+	}
+	light, err := parsePercent(hslTriplet[2])
+	if err != nil {
+		return HSL{}, err
+	}
+	return HSL{hue, sat, light}, nil
+}
+
+// NormalizeHSLTriplet Normalize an hsl triplet so that the hue is in degrees within the range [0, 360)
+// and the saturation and lightness are within the range 0%-100% inclusive.
+func NormalizeHSLTriplet(hslTriplet []string) ([]string, error) {
+	hsl, err := parseHSLTriplet(hslTriplet)
+	if err != nil {
+		return nil, err
+	}
+	return HSL{hsl.H, clampUnit(hsl.S/100) * 100, clampUnit(hsl.L/100) * 100}.Strings(), nil
+}
+
+// # Conversions from hsl() triplets to various formats.
+// #################################################################
+
+// HSLToRGB Convert a 3-tuple of strings, suitable for use in an hsl color triplet, to a 3-tuple of integers suitable for use in an rgb triplet specifying that color
+func HSLToRGB(hslTriplet []string) ([]int, error) {
+	hsl, err := parseHSLTriplet(hslTriplet)
+	if err != nil {
+		return []int{}, err
+	}
+	return hsl.Color().IntegerRGB().Slice(), nil
+}
+
+// HSLToRGBPercent Convert a 3-tuple of strings, suitable for use in an hsl color triplet, to a 3-tuple of percentages suitable for use in an rgb triplet specifying that color
+func HSLToRGBPercent(hslTriplet []string) ([]string, error) {
+	rgb, err := HSLToRGB(hslTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToRGBPercent(rgb)
+}
+
+// HSLToHex Convert a 3-tuple of strings, suitable for use in an hsl color triplet, to a normalized hexadecimal value for that color
+func HSLToHex(hslTriplet []string) (string, error) {
+	rgb, err := HSLToRGB(hslTriplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBToHex(rgb), nil
+}
+
+// HSLToName Convert a 3-tuple of strings, suitable for use in an hsl color triplet, to its corresponding normalized color name, if any such name exists
+func HSLToName(hslTriplet []string, spec string) (string, error) {
+	rgb, err := HSLToRGB(hslTriplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBToName(rgb, spec)
+}
+
+// HSLAToRGBA Convert a 4-tuple of strings, suitable for use in an hsla color quadruplet, to a 4-tuple of integers suitable for use in an rgba quadruplet specifying that color
+//
+// As in hsla(), the alpha is a number from 0 to 1 such as "0.5", or a percentage such as "50%".
+func HSLAToRGBA(hslaQuadruplet []string) ([]int, error) {
+	if err := checkQuadruplet(len(hslaQuadruplet)); err != nil {
+		return []int{}, err
+	}
+	rgb, err := HSLToRGB(hslaQuadruplet[:3])
+	if err != nil {
+		return []int{}, err
+	}
+	alpha, err := ParseAlpha(hslaQuadruplet[3])
+	if err != nil {
+		return []int{}, err
+	}
+	return append(rgb, unitToInteger(alpha)), nil
+}
+
+// HSLAToHex Convert a 4-tuple of strings, suitable for use in an hsla color quadruplet, to an 8 digit hexadecimal value for that color
+func HSLAToHex(hslaQuadruplet []string) (string, error) {
+	rgba, err := HSLAToRGBA(hslaQuadruplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBAToHex(rgba), nil
+}
+
+// # Conversions from various formats to hsl() triplets.
+// #################################################################
+
+// NameToHSL Convert a color name to a 3-tuple of strings suitable for use in an hsl triplet specifying that color
+func NameToHSL(name string, spec string) ([]string, error) {
+	rgb, err := NameToRGB(name, spec)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHSL(rgb)
+}
+
+// HexToHSL Convert a hexadecimal color value to a 3-tuple of strings suitable for use in an hsl triplet specifying that color
+func HexToHSL(hexValue string) ([]string, error) {
+	rgb, err := HexToRGB(hexValue)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHSL(rgb)
+}
+
+// RGBToHSL Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to a 3-tuple of strings suitable for use in an hsl triplet specifying that color
+func RGBToHSL(rgbTriplet []int) ([]string, error) {
+	rgb, err := IntegerRGBFromSlice(rgbTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return rgb.Color().HSL().Strings(), nil
+}
+
+// RGBPercentToHSL Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to a 3-tuple of strings suitable for use in an hsl triplet specifying that color
+func RGBPercentToHSL(rgbPercentTriplet []string) ([]string, error) {
+	rgb, err := RGBPercentToRGB(rgbPercentTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHSL(rgb)
+}
+
+// HexToHSLA Convert a hexadecimal color value to a 4-tuple of strings suitable for use in an hsla quadruplet specifying that color
+func HexToHSLA(hexValue string) ([]string, error) {
+	rgba, err := HexToRGBA(hexValue)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBAToHSLA(rgba)
+}
+
+// RGBAToHSLA Convert a 4-tuple of integers, suitable for use in an rgba color quadruplet, to a 4-tuple of strings suitable for use in an hsla quadruplet specifying that color
+func RGBAToHSLA(rgbaQuadruplet []int) ([]string, error) {
+	if err := checkQuadruplet(len(rgbaQuadruplet)); err != nil {
+		return []string{}, err
+	}
+	hsl, err := RGBToHSL(rgbaQuadruplet[:3])
+	if err != nil {
+		return []string{}, err
+	}
+	rgbaPercent, err := RGBAToRGBAPercent(rgbaQuadruplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return append(hsl, rgbaPercent[3]), nil
+}
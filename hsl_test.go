@@ -0,0 +1,120 @@
+package webcolors
+
+import "testing"
+
+func TestParseHue(t *testing.T) {
+	cases := map[string]float64{
+		"120":     120,
+		"120deg":  120,
+		"0.5turn": 180,
+		"200grad": 180,
+		"-90":     -90,
+	}
+	for input, expected := range cases {
+		value, err := ParseHue(input)
+		if err != nil || value != expected {
+			t.Error(input, "expected", expected, "got", value, err)
+		}
+	}
+	if _, err := ParseHue("120%"); err == nil {
+		t.Error("expected an error for 120%")
+	}
+}
+
+func TestNormalizeHSLTriplet(t *testing.T) {
+	value, _ := NormalizeHSLTriplet([]string{"-0.25turn", "120%", "-5%"})
+	expected := []string{"270", "100%", "0%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHSLToRGB(t *testing.T) {
+	value, _ := HSLToRGB([]string{"240deg", "100%", "25.1%"})
+	expected := []int{0, 0, 128}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHSLToHex(t *testing.T) {
+	value, _ := HSLToHex([]string{"0.5turn", "100%", "50%"})
+	if value != "#00ffff" {
+		t.Error("expected #00ffff, got", value)
+	}
+}
+
+func TestHSLToName(t *testing.T) {
+	value, _ := HSLToName([]string{"120", "100%", "25.1%"}, "css3")
+	if value != "green" {
+		t.Error("expected green, got", value)
+	}
+}
+
+func TestNameToHSL(t *testing.T) {
+	value, _ := NameToHSL("goldenrod", "css3")
+	expected := []string{"42.9", "74.4%", "49.02%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHexToHSLA(t *testing.T) {
+	value, _ := HexToHSLA("#ff000080")
+	expected := []string{"0", "100%", "50%", "50%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHSLAToHex(t *testing.T) {
+	value, _ := HSLAToHex([]string{"0", "100%", "50%", "50%"})
+	if value != "#ff000080" {
+		t.Error("expected #ff000080, got", value)
+	}
+}
+
+func TestHSLAToRGBA(t *testing.T) {
+	value, _ := HSLAToRGBA([]string{"0", "100%", "50%", "0.5"})
+	expected := []int{255, 0, 0, 128}
+	for i := range expected {
+		if len(value) != 4 || value[i] != expected[i] {
+			t.Error("expected", expected, "got", value)
+			break
+		}
+	}
+	if _, err := HSLAToRGBA([]string{"0", "100%", "50%", "half"}); err == nil {
+		t.Error("expected an error for an alpha of half")
+	}
+}
+
+func TestParseAlpha(t *testing.T) {
+	expected := map[string]float64{"0.5": 0.5, "50%": 0.5, "1": 1, "2": 1, "-10%": 0}
+	for alpha, v := range expected {
+		if value, err := ParseAlpha(alpha); err != nil || value != v {
+			t.Error(alpha, "expected", v, "got", value, err)
+		}
+	}
+}
+
+func TestHSLRoundTrip(t *testing.T) {
+	for _, hexValue := range CSS3NamesToHex {
+		if len(hexValue) > 7 {
+			// transparent has no hsl triplet
+			continue
+		}
+		hsl, _ := HexToHSL(hexValue)
+		value, _ := HSLToHex(hsl)
+		if value != hexValue {
+			t.Error("expected", hexValue, "got", value, "through", hsl)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package webcolors
+
+import (
+	"math"
+)
+
+// # HWB color values.
+// #################################################################
+
+// HWBSpace the hwb form of sRGB: a hue angle in degrees, and a whiteness and
+// blackness within the range 0-100 inclusive
+const HWBSpace Space = "hwb"
+
+func init() {
+	toBase := func(c [3]float64) [3]float64 {
+		return hwbToSRGB(c[0], c[1]/100, c[2]/100)
+	}
+	fromBase := func(c [3]float64) [3]float64 {
+		hue, white, black := srgbToHWB(c)
+		return [3]float64{hue, white * 100, black * 100}
+	}
+	spaceConversions[HWBSpace] = spaceConversion{
+		toXYZ:    func(c [3]float64) [3]float64 { return spaceConversions[SRGB].toXYZ(toBase(c)) },
+		fromXYZ:  func(c [3]float64) [3]float64 { return fromBase(spaceConversions[SRGB].fromXYZ(c)) },
+		base:     SRGB,
+		toBase:   toBase,
+		fromBase: fromBase,
+	}
+}
+
+// hwbToSRGB Internal helper converting a hue in degrees and a whiteness and blackness
+// within 0-1 to sRGB channels, following the reference algorithm of the CSS Color specification:
+//
+// https://www.w3.org/TR/css-color-4/#hwb-to-rgb
+func hwbToSRGB(hue, white, black float64) [3]float64 {
+	if white+black >= 1 {
+		gray := white / (white + black)
+		return [3]float64{gray, gray, gray}
+	}
+	rgb := hslToSRGB(hue, 1, 0.5)
+	for i := range rgb {
+		rgb[i] = rgb[i]*(1-white-black) + white
+	}
+	return rgb
+}
+
+// srgbToHWB Internal helper converting sRGB channels to a hue in degrees and a whiteness
+// and blackness within 0-1, following the reference algorithm of the CSS Color specification.
+//
+// https://www.w3.org/TR/css-color-4/#rgb-to-hwb
+func srgbToHWB(rgb [3]float64) (hue, white, black float64) {
+	hue, _, _ = srgbToHSL(rgb)
+	white = math.Min(rgb[0], math.Min(rgb[1], rgb[2]))
+	black = 1 - math.Max(rgb[0], math.Max(rgb[1], rgb[2]))
+	return hue, white, black
+}
+
+// HWB an hwb triplet: a hue angle in degrees, and a whiteness and blackness
+// within the range 0-100 inclusive.
+type HWB struct {
+	H, W, B float64
+}
+
+// HWB Convert the color to an hwb triplet, clamping out of range channels
+func (c Color) HWB() HWB {
+	hue, white, black := srgbToHWB(clampChannels(c.srgb()))
+	return HWB{hue, white * 100, black * 100}
+}
+
+// Color Convert the triplet to an opaque Color
+func (t HWB) Color() Color {
+	rgb := hwbToSRGB(t.H, clampUnit(t.W/100), clampUnit(t.B/100))
+	return Color{Space: SRGB, Channels: clampChannels(rgb), Alpha: 1}
+}
+
+// Strings Convert the triplet to a 3-tuple of strings such as "120", "0%", "50%"
+func (t HWB) Strings() []string {
+	return []string{
+		formatNumber(normalizeHue(t.H), 2),
+		formatNumber(t.W, 2) + "%",
+		formatNumber(t.B, 2) + "%",
+	}
+}
+
+// parseHWBTriplet Internal helper parsing a 3-tuple of strings suitable for use in an hwb triplet
+func parseHWBTriplet(hwbTriplet []string) (HWB, error) {
+	if len(hwbTriplet) != 3 {
+		return HWB{}, arityError(len(hwbTriplet), "3 values for an hwb triplet")
+	}
+	hue, err := ParseHue(hwbTriplet[0])
+	if err != nil {
+		return HWB{}, err
+	}
+	white, err := parsePercent(hwbTriplet[1])
+	if err != nil {
+		return HWB{}, err
+	}
+	black, err := parsePercent(hwbTriplet[2])
+	if err != nil {
+		return HWB{}, err
+	}
+	return HWB{hue, white, black}, nil
+}
+
+// NormalizeHWBTriplet Normalize an hwb triplet so that the hue is in degrees within the range [0, 360)
+// and the whiteness and blackness are within the range 0%-100% inclusive.
+func NormalizeHWBTriplet(hwbTriplet []string) ([]string, error) {
+	hwb, err := parseHWBTriplet(hwbTriplet)
+	if err != nil {
+		return nil, err
+	}
+	return HWB{hwb.H, clampUnit(hwb.W/100) * 100, clampUnit(hwb.B/100) * 100}.Strings(), nil
+}
+
+// # Conversions from hwb() triplets to various formats.
+// #################################################################
+
+// HWBToRGB Convert a 3-tuple of strings, suitable for use in an hwb color triplet, to a 3-tuple of integers suitable for use in an rgb triplet specifying that color.
+//
+// When the whiteness and blackness add up to 100% or more, the color is
+// the gray of their relative proportions.
+func HWBToRGB(hwbTriplet []string) ([]int, error) {
+	hwb, err := parseHWBTriplet(hwbTriplet)
+	if err != nil {
+		return []int{}, err
+	}
+	return hwb.Color().IntegerRGB().Slice(), nil
+}
+
+// HWBToRGBPercent Convert a 3-tuple of strings, suitable for use in an hwb color triplet, to a 3-tuple of percentages suitable for use in an rgb triplet specifying that color
+func HWBToRGBPercent(hwbTriplet []string) ([]string, error) {
+	rgb, err := HWBToRGB(hwbTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToRGBPercent(rgb)
+}
+
+// HWBToHex Convert a 3-tuple of strings, suitable for use in an hwb color triplet, to a normalized hexadecimal value for that color
+func HWBToHex(hwbTriplet []string) (string, error) {
+	rgb, err := HWBToRGB(hwbTriplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBToHex(rgb), nil
+}
+
+// HWBToName Convert a 3-tuple of strings, suitable for use in an hwb color triplet, to its corresponding normalized color name, if any such name exists
+func HWBToName(hwbTriplet []string, spec string) (string, error) {
+	rgb, err := HWBToRGB(hwbTriplet)
+	if err != nil {
+		return "", err
+	}
+	return RGBToName(rgb, spec)
+}
+
+// # Conversions from various formats to hwb() triplets.
+// #################################################################
+
+// NameToHWB Convert a color name to a 3-tuple of strings suitable for use in an hwb triplet specifying that color
+func NameToHWB(name string, spec string) ([]string, error) {
+	rgb, err := NameToRGB(name, spec)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHWB(rgb)
+}
+
+// HexToHWB Convert a hexadecimal color value to a 3-tuple of strings suitable for use in an hwb triplet specifying that color
+func HexToHWB(hexValue string) ([]string, error) {
+	rgb, err := HexToRGB(hexValue)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHWB(rgb)
+}
+
+// RGBToHWB Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to a 3-tuple of strings suitable for use in an hwb triplet specifying that color
+func RGBToHWB(rgbTriplet []int) ([]string, error) {
+	rgb, err := IntegerRGBFromSlice(rgbTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return rgb.Color().HWB().Strings(), nil
+}
+
+// RGBPercentToHWB Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to a 3-tuple of strings suitable for use in an hwb triplet specifying that color
+func RGBPercentToHWB(rgbPercentTriplet []string) ([]string, error) {
+	rgb, err := RGBPercentToRGB(rgbPercentTriplet)
+	if err != nil {
+		return []string{}, err
+	}
+	return RGBToHWB(rgb)
+}
@@ -0,0 +1,71 @@
+package webcolors
+
+import "testing"
+
+func TestNormalizeHWBTriplet(t *testing.T) {
+	value, _ := NormalizeHWBTriplet([]string{"400", "-10%", "120%"})
+	expected := []string{"40", "0%", "100%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHWBToRGB(t *testing.T) {
+	value, _ := HWBToRGB([]string{"240", "0%", "49.8%"})
+	expected := []int{0, 0, 128}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHWBToRGBGray(t *testing.T) {
+	value, _ := HWBToRGB([]string{"120", "60%", "60%"})
+	expected := []int{128, 128, 128}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHWBToHex(t *testing.T) {
+	value, _ := HWBToHex([]string{"0.5turn", "0%", "0%"})
+	if value != "#00ffff" {
+		t.Error("expected #00ffff, got", value)
+	}
+}
+
+func TestHWBToName(t *testing.T) {
+	value, _ := HWBToName([]string{"0", "100%", "0%"}, "css3")
+	if value != "white" {
+		t.Error("expected white, got", value)
+	}
+}
+
+func TestNameToHWB(t *testing.T) {
+	value, _ := NameToHWB("goldenrod", "css3")
+	expected := []string{"42.9", "12.55%", "14.51%"}
+	for i := range expected {
+		if value[i] != expected[i] {
+			t.Error("expected", expected[i], " got", value[i])
+		}
+	}
+}
+
+func TestHWBRoundTrip(t *testing.T) {
+	for _, hexValue := range CSS3NamesToHex {
+		if len(hexValue) > 7 {
+			// transparent has no hwb triplet
+			continue
+		}
+		hwb, _ := HexToHWB(hexValue)
+		value, _ := HWBToHex(hwb)
+		if value != hexValue {
+			t.Error("expected", hexValue, "got", value, "through", hwb)
+		}
+	}
+}
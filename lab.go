@@ -0,0 +1,232 @@
+package webcolors
+
+// # CIE Lab, LCH, Oklab and OKLCH color values.
+// #################################################################
+//
+// Lab and LCH are relative to the D50 white point and Oklab and OKLCH to the
+// D65 white point of sRGB, as in CSS; the conversions adapt between the two.
+// Converting to the sRGB formats clamps colors outside of the sRGB gamut.
+
+// Lab a CIE Lab color: a lightness within 0-100 and the a and b axes,
+// roughly within -125 to 125 for real colors.
+type Lab struct {
+	L, A, B float64
+}
+
+// LCH a CIE LCH color: a lightness within 0-100, a chroma from 0,
+// roughly up to 150 for real colors, and a hue angle in degrees.
+type LCH struct {
+	L, C, H float64
+}
+
+// OKLab an Oklab color: a lightness within 0-1 and the a and b axes,
+// roughly within -0.4 to 0.4 for real colors.
+type OKLab struct {
+	L, A, B float64
+}
+
+// OKLCH an OKLCH color: a lightness within 0-1, a chroma from 0,
+// roughly up to 0.4 for real colors, and a hue angle in degrees.
+type OKLCH struct {
+	L, C, H float64
+}
+
+// Lab Convert the color to CIE Lab
+func (c Color) Lab() Lab {
+	ch := c.convert(LabSpace).Channels
+	return Lab{ch[0], ch[1], ch[2]}
+}
+
+// LCH Convert the color to CIE LCH
+func (c Color) LCH() LCH {
+	ch := c.convert(LCHSpace).Channels
+	return LCH{ch[0], ch[1], ch[2]}
+}
+
+// OKLab Convert the color to Oklab
+func (c Color) OKLab() OKLab {
+	ch := c.convert(OKLabSpace).Channels
+	return OKLab{ch[0], ch[1], ch[2]}
+}
+
+// OKLCH Convert the color to OKLCH
+func (c Color) OKLCH() OKLCH {
+	ch := c.convert(OKLCHSpace).Channels
+	return OKLCH{ch[0], ch[1], ch[2]}
+}
+
+// Color Convert the Lab value to an opaque Color in LabSpace
+func (t Lab) Color() Color {
+	return Color{Space: LabSpace, Channels: [3]float64{t.L, t.A, t.B}, Alpha: 1}
+}
+
+// Color Convert the LCH value to an opaque Color in LCHSpace
+func (t LCH) Color() Color {
+	return Color{Space: LCHSpace, Channels: [3]float64{t.L, t.C, t.H}, Alpha: 1}
+}
+
+// Color Convert the Oklab value to an opaque Color in OKLabSpace
+func (t OKLab) Color() Color {
+	return Color{Space: OKLabSpace, Channels: [3]float64{t.L, t.A, t.B}, Alpha: 1}
+}
+
+// Color Convert the OKLCH value to an opaque Color in OKLCHSpace
+func (t OKLCH) Color() Color {
+	return Color{Space: OKLCHSpace, Channels: [3]float64{t.L, t.C, t.H}, Alpha: 1}
+}
+
+// rgbToColor Internal helper converting a 3-tuple of integers to an opaque Color
+func rgbToColor(rgbTriplet []int) (Color, error) {
+	rgb, err := IntegerRGBFromSlice(rgbTriplet)
+	if err != nil {
+		return Color{}, err
+	}
+	return rgb.Color(), nil
+}
+
+// rgbPercentToColor Internal helper converting a 3-tuple of percentages to an opaque Color
+func rgbPercentToColor(rgbPercentTriplet []string) (Color, error) {
+	rgb, err := PercentRGBFromSlice(rgbPercentTriplet)
+	if err != nil {
+		return Color{}, err
+	}
+	return rgb.Color(), nil
+}
+
+// # Conversions from the sRGB formats to Lab, LCH, Oklab and OKLCH.
+// #################################################################
+
+// HexToLab Convert a hexadecimal color value to CIE Lab
+func HexToLab(hexValue string) (Lab, error) {
+	c, err := Hex(hexValue).Color()
+	return c.Lab(), err
+}
+
+// RGBToLab Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to CIE Lab
+func RGBToLab(rgbTriplet []int) (Lab, error) {
+	c, err := rgbToColor(rgbTriplet)
+	return c.Lab(), err
+}
+
+// RGBPercentToLab Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to CIE Lab
+func RGBPercentToLab(rgbPercentTriplet []string) (Lab, error) {
+	c, err := rgbPercentToColor(rgbPercentTriplet)
+	return c.Lab(), err
+}
+
+// HexToLCH Convert a hexadecimal color value to CIE LCH
+func HexToLCH(hexValue string) (LCH, error) {
+	c, err := Hex(hexValue).Color()
+	return c.LCH(), err
+}
+
+// RGBToLCH Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to CIE LCH
+func RGBToLCH(rgbTriplet []int) (LCH, error) {
+	c, err := rgbToColor(rgbTriplet)
+	return c.LCH(), err
+}
+
+// RGBPercentToLCH Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to CIE LCH
+func RGBPercentToLCH(rgbPercentTriplet []string) (LCH, error) {
+	c, err := rgbPercentToColor(rgbPercentTriplet)
+	return c.LCH(), err
+}
+
+// HexToOKLab Convert a hexadecimal color value to Oklab
+func HexToOKLab(hexValue string) (OKLab, error) {
+	c, err := Hex(hexValue).Color()
+	return c.OKLab(), err
+}
+
+// RGBToOKLab Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to Oklab
+func RGBToOKLab(rgbTriplet []int) (OKLab, error) {
+	c, err := rgbToColor(rgbTriplet)
+	return c.OKLab(), err
+}
+
+// RGBPercentToOKLab Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to Oklab
+func RGBPercentToOKLab(rgbPercentTriplet []string) (OKLab, error) {
+	c, err := rgbPercentToColor(rgbPercentTriplet)
+	return c.OKLab(), err
+}
+
+// HexToOKLCH Convert a hexadecimal color value to OKLCH
+func HexToOKLCH(hexValue string) (OKLCH, error) {
+	c, err := Hex(hexValue).Color()
+	return c.OKLCH(), err
+}
+
+// RGBToOKLCH Convert a 3-tuple of integers, suitable for use in an rgb color triplet, to OKLCH
+func RGBToOKLCH(rgbTriplet []int) (OKLCH, error) {
+	c, err := rgbToColor(rgbTriplet)
+	return c.OKLCH(), err
+}
+
+// RGBPercentToOKLCH Convert a 3-tuple of percentages, suitable for use in an rgb color triplet, to OKLCH
+func RGBPercentToOKLCH(rgbPercentTriplet []string) (OKLCH, error) {
+	c, err := rgbPercentToColor(rgbPercentTriplet)
+	return c.OKLCH(), err
+}
+
+// # Conversions from Lab, LCH, Oklab and OKLCH to the sRGB formats.
+// #################################################################
+
+// LabToHex Convert a CIE Lab value to a normalized hexadecimal value
+func LabToHex(lab Lab) string {
+	return string(lab.Color().Hex())
+}
+
+// LabToRGB Convert a CIE Lab value to a 3-tuple of integers suitable for use in an rgb triplet
+func LabToRGB(lab Lab) []int {
+	return lab.Color().IntegerRGB().Slice()
+}
+
+// LabToRGBPercent Convert a CIE Lab value to a 3-tuple of percentages suitable for use in an rgb triplet
+func LabToRGBPercent(lab Lab) ([]string, error) {
+	return RGBToRGBPercent(LabToRGB(lab))
+}
+
+// LCHToHex Convert a CIE LCH value to a normalized hexadecimal value
+func LCHToHex(lch LCH) string {
+	return string(lch.Color().Hex())
+}
+
+// LCHToRGB Convert a CIE LCH value to a 3-tuple of integers suitable for use in an rgb triplet
+func LCHToRGB(lch LCH) []int {
+	return lch.Color().IntegerRGB().Slice()
+}
+
+// LCHToRGBPercent Convert a CIE LCH value to a 3-tuple of percentages suitable for use in an rgb triplet
+func LCHToRGBPercent(lch LCH) ([]string, error) {
+	return RGBToRGBPercent(LCHToRGB(lch))
+}
+
+// OKLabToHex Convert an Oklab value to a normalized hexadecimal value
+func OKLabToHex(oklab OKLab) string {
+	return string(oklab.Color().Hex())
+}
+
+// OKLabToRGB Convert an Oklab value to a 3-tuple of integers suitable for use in an rgb triplet
+func OKLabToRGB(oklab OKLab) []int {
+	return oklab.Color().IntegerRGB().Slice()
+}
+
+// OKLabToRGBPercent Convert an Oklab value to a 3-tuple of percentages suitable for use in an rgb triplet
+func OKLabToRGBPercent(oklab OKLab) ([]string, error) {
+	return RGBToRGBPercent(OKLabToRGB(oklab))
+}
+
+// OKLCHToHex Convert an OKLCH value to a normalized hexadecimal value
+func OKLCHToHex(oklch OKLCH) string {
+	return string(oklch.Color().Hex())
+}
+
+// OKLCHToRGB Convert an OKLCH value to a 3-tuple of integers suitable for use in an rgb triplet
+func OKLCHToRGB(oklch OKLCH) []int {
+	return oklch.Color().IntegerRGB().Slice()
+}
+
+// OKLCHToRGBPercent Convert an OKLCH value to a 3-tuple of percentages suitable for use in an rgb triplet
+func OKLCHToRGBPercent(oklch OKLCH) ([]string, error) {
+	return RGBToRGBPercent(OKLCHToRGB(oklch))
+}
@@ -0,0 +1,93 @@
+package webcolors
+
+import (
+	"math"
+	"testing"
+)
+
+// closeTo Internal helper comparing floats up to a tolerance
+func closeTo(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestHexToLab(t *testing.T) {
+	value, _ := HexToLab("#ffffff")
+	if !closeTo(value.L, 100, 1e-4) || !closeTo(value.A, 0, 1e-4) || !closeTo(value.B, 0, 1e-4) {
+		t.Error("expected 100 0 0, got", value)
+	}
+	value, _ = HexToLab("#ff0000")
+	if !closeTo(value.L, 54.29, 0.01) || !closeTo(value.A, 80.80, 0.01) || !closeTo(value.B, 69.89, 0.01) {
+		t.Error("expected 54.29 80.80 69.89, got", value)
+	}
+}
+
+func TestRGBToLCH(t *testing.T) {
+	value, _ := RGBToLCH([]int{0, 0, 255})
+	if !closeTo(value.L, 29.57, 0.01) || !closeTo(value.C, 131.2, 0.1) || !closeTo(value.H, 301.36, 0.01) {
+		t.Error("expected 29.57 131.2 301.36, got", value)
+	}
+}
+
+func TestHexToOKLab(t *testing.T) {
+	value, _ := HexToOKLab("#ffffff")
+	if !closeTo(value.L, 1, 1e-6) || !closeTo(value.A, 0, 1e-6) || !closeTo(value.B, 0, 1e-6) {
+		t.Error("expected 1 0 0, got", value)
+	}
+}
+
+func TestRGBPercentToOKLCH(t *testing.T) {
+	value, _ := RGBPercentToOKLCH([]string{"100%", "0%", "0%"})
+	if !closeTo(value.L, 0.628, 0.001) || !closeTo(value.C, 0.2577, 0.001) || !closeTo(value.H, 29.23, 0.01) {
+		t.Error("expected 0.628 0.2577 29.23, got", value)
+	}
+}
+
+func TestLabRoundTrip(t *testing.T) {
+	for _, hexValue := range CSS3NamesToHex {
+		expected := NormalizeHex(hexValue)[:7]
+		lab, _ := HexToLab(hexValue)
+		lch, _ := HexToLCH(hexValue)
+		oklab, _ := HexToOKLab(hexValue)
+		oklch, _ := HexToOKLCH(hexValue)
+		for _, value := range []string{LabToHex(lab), LCHToHex(lch), OKLabToHex(oklab), OKLCHToHex(oklch)} {
+			if value != expected {
+				t.Error("expected", expected, "got", value)
+			}
+		}
+	}
+}
+
+func TestOKLCHToName(t *testing.T) {
+	value, _ := HexToName(OKLCHToHex(OKLCH{0.5198, 0.1769, 142.5}), "css3")
+	if value != "green" {
+		t.Error("expected green, got", value)
+	}
+}
+
+func TestParseColorLab(t *testing.T) {
+	cases := map[string]string{
+		"lab(54.29 80.8 69.89)":            "#ff0000",
+		"lab(100% 0 0)":                    "#ffffff",
+		"lch(29.57 131.2 301.36deg)":       "#0000ff",
+		"oklab(62.8% 0.2249 0.1258)":       "#ff0000",
+		"oklch(0.5198 0.1769 142.5 / 50%)": "#00800080",
+	}
+	for input, expected := range cases {
+		value, err := ParseColor(input, "css4")
+		if err != nil || value.Hex() != Hex(expected) {
+			t.Error(input, "expected", expected, "got", value.Hex(), err)
+		}
+	}
+}
+
+func TestFormatLab(t *testing.T) {
+	red := IntegerRGB{255, 0, 0}.Color()
+	value, _ := Format(red, NotationLab, FormatOptions{})
+	if value != "lab(54.29 80.8 69.89)" {
+		t.Error("expected lab(54.29 80.8 69.89), got", value)
+	}
+	value, _ = Format(red, NotationOKLCH, FormatOptions{})
+	if value != "oklch(0.628 0.2577 29.2339)" {
+		t.Error("expected oklch(0.628 0.2577 29.2339), got", value)
+	}
+}
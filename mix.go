@@ -0,0 +1,166 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+)
+
+// # Color mixing.
+// #################################################################
+//
+// Mix follows the color-mix() function of CSS Color Level 5: both colors are
+// converted to the interpolation space, their channels premultiplied by their
+// alpha, and interpolated linearly, hues taking the arc selected by the hue
+// interpolation method.
+//
+// https://www.w3.org/TR/css-color-5/#color-mix
+
+// HueMethod a CSS hue interpolation method, selecting which arc of the hue
+// circle the hue of a polar interpolation space follows
+type HueMethod string
+
+const (
+	// HueShorter the shorter arc, the default
+	HueShorter HueMethod = "shorter"
+	// HueLonger the longer arc
+	HueLonger HueMethod = "longer"
+	// HueIncreasing the arc going clockwise, from lower to higher hue angles
+	HueIncreasing HueMethod = "increasing"
+	// HueDecreasing the arc going counterclockwise, from higher to lower hue angles
+	HueDecreasing HueMethod = "decreasing"
+)
+
+// mixSpaces the interpolation spaces of Mix, mapped to the index of their hue channel, -1 if none;
+// xyz is an alias of xyz-d65
+var mixSpaces = map[Space]int{
+	SRGB:       -1,
+	SRGBLinear: -1,
+	LabSpace:   -1,
+	OKLabSpace: -1,
+	XYZD50:     -1,
+	XYZD65:     -1,
+	"xyz":      -1,
+	LCHSpace:   2,
+	OKLCHSpace: 2,
+	HSLSpace:   0,
+	HWBSpace:   0,
+}
+
+// Mix Mix two colors like color-mix(in space, a percentage, b), percentage being
+// the share of a within the range 0-100 inclusive. The result is in the
+// interpolation space, oklab when empty; hue methods other than the empty
+// HueShorter only apply to the polar spaces lch, oklch, hsl and hwb.
+func Mix(a, b Color, percentage float64, space Space, hueMethod HueMethod) (Color, error) {
+	if space == "" {
+		space = OKLabSpace
+	}
+	hueIndex, ok := mixSpaces[space]
+	if !ok {
+		return Color{}, invalidValueError(string(space), "a supported interpolation space")
+	}
+	if space == "xyz" {
+		space = XYZD65
+	}
+	if percentage < 0 || percentage > 100 || math.IsNaN(percentage) {
+		return Color{}, invalidValueError(strconv.FormatFloat(percentage, 'g', -1, 64), "a percentage within 0-100")
+	}
+	if _, _, err := fixupHues(0, 0, hueMethod); err != nil {
+		return Color{}, err
+	}
+	from, err := a.Convert(space)
+	if err != nil {
+		return Color{}, err
+	}
+	to, err := b.Convert(space)
+	if err != nil {
+		return Color{}, err
+	}
+	if hueIndex >= 0 {
+		// the hue of an achromatic color is powerless, and takes the hue of the other color
+		if hueIsPowerless(from) {
+			from.Channels[hueIndex] = to.Channels[hueIndex]
+		} else if hueIsPowerless(to) {
+			to.Channels[hueIndex] = from.Channels[hueIndex]
+		}
+		from.Channels[hueIndex], to.Channels[hueIndex], _ = fixupHues(from.Channels[hueIndex], to.Channels[hueIndex], hueMethod)
+	}
+	p := percentage / 100
+	fromAlpha, toAlpha := clampUnit(from.Alpha), clampUnit(to.Alpha)
+	alpha := fromAlpha*p + toAlpha*(1-p)
+	mixed := Color{Space: space, Alpha: alpha}
+	for i := range mixed.Channels {
+		if i == hueIndex {
+			mixed.Channels[i] = normalizeHue(from.Channels[i]*p + to.Channels[i]*(1-p))
+			continue
+		}
+		// premultiplied alpha keeps transparent colors from tinting the mix
+		mixed.Channels[i] = from.Channels[i]*fromAlpha*p + to.Channels[i]*toAlpha*(1-p)
+		if alpha != 0 {
+			mixed.Channels[i] /= alpha
+		}
+	}
+	return mixed, nil
+}
+
+// hueIsPowerless Internal helper reporting whether the hue of a color in a polar space has no effect
+func hueIsPowerless(c Color) bool {
+	switch c.Space {
+	case LCHSpace, OKLCHSpace, HSLSpace:
+		return c.Channels[1] <= achromaticChroma
+	case HWBSpace:
+		return c.Channels[1]+c.Channels[2] >= 100-achromaticChroma
+	}
+	return false
+}
+
+// fixupHues Internal helper adjusting two hues in degrees so that interpolating
+// linearly between them follows the arc of the hue method
+//
+// https://www.w3.org/TR/css-color-4/#hue-interpolation
+func fixupHues(h1, h2 float64, hueMethod HueMethod) (float64, float64, error) {
+	h1, h2 = normalizeHue(h1), normalizeHue(h2)
+	delta := h2 - h1
+	switch hueMethod {
+	case "", HueShorter:
+		if delta > 180 {
+			h1 += 360
+		} else if delta < -180 {
+			h2 += 360
+		}
+	case HueLonger:
+		if 0 < delta && delta < 180 {
+			h1 += 360
+		} else if -180 < delta && delta <= 0 {
+			h2 += 360
+		}
+	case HueIncreasing:
+		if delta < 0 {
+			h2 += 360
+		}
+	case HueDecreasing:
+		if delta > 0 {
+			h1 += 360
+		}
+	default:
+		return 0, 0, invalidValueError(string(hueMethod), "a supported hue interpolation method")
+	}
+	return h1, h2, nil
+}
+
+// MixHex Mix two hexadecimal color values like Mix, returning a normalized hexadecimal
+// value, of 8 digits when the mix is translucent
+func MixHex(a, b string, percentage float64, space Space, hueMethod HueMethod) (string, error) {
+	from, err := Hex(a).Color()
+	if err != nil {
+		return "", err
+	}
+	to, err := Hex(b).Color()
+	if err != nil {
+		return "", err
+	}
+	mixed, err := Mix(from, to, percentage, space, hueMethod)
+	if err != nil {
+		return "", err
+	}
+	return string(mixed.Hex()), nil
+}
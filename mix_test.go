@@ -0,0 +1,76 @@
+package webcolors
+
+import "testing"
+
+func TestMixHex(t *testing.T) {
+	expected := map[Space]string{
+		SRGB:       "#800080",
+		SRGBLinear: "#bc00bc",
+		"xyz":      "#bc00bc",
+		LabSpace:   "#c10088",
+		OKLabSpace: "#8c53a2",
+		LCHSpace:   "#f50086",
+		OKLCHSpace: "#ba00c2",
+		HSLSpace:   "#ff00ff",
+		HWBSpace:   "#ff00ff",
+	}
+	for space, hx := range expected {
+		value, _ := MixHex("#ff0000", "#0000ff", 50, space, "")
+		if value != hx {
+			t.Error(space, "expected", hx, "got", value)
+		}
+	}
+	value, _ := MixHex("#ff0000", "#0000ff", 25, SRGB, "")
+	if value != "#4000bf" {
+		t.Error("expected #4000bf, got", value)
+	}
+}
+
+func TestMixHueMethods(t *testing.T) {
+	green, _ := ParseColor("hsl(120deg 100% 50%)", CSS3)
+	violet, _ := ParseColor("hsl(280deg 100% 50%)", CSS3)
+	expected := map[HueMethod]float64{
+		HueShorter:    200,
+		HueLonger:     20,
+		HueIncreasing: 200,
+		HueDecreasing: 20,
+	}
+	for method, hue := range expected {
+		value, _ := Mix(green, violet, 50, HSLSpace, method)
+		if value.Space != HSLSpace || !closeTo(value.Channels[0], hue, 1e-9) {
+			t.Error(method, "expected a hue of", hue, "got", value)
+		}
+	}
+	_, err := Mix(green, violet, 50, HSLSpace, "sideways")
+	if err == nil {
+		t.Error("expected an error for an unsupported hue method")
+	}
+}
+
+func TestMixPowerlessHue(t *testing.T) {
+	value, _ := MixHex("#ffffff", "#0000ff", 50, HSLSpace, "")
+	if value != "#9f9fdf" {
+		t.Error("expected #9f9fdf, got", value)
+	}
+	value, _ = MixHex("#ffffff", "#0000ff", 50, HWBSpace, "")
+	if value != "#8080ff" {
+		t.Error("expected #8080ff, got", value)
+	}
+}
+
+func TestMixAlpha(t *testing.T) {
+	value, _ := MixHex("#ff000000", "#0000ff", 50, SRGB, "")
+	if value != "#0000ff80" {
+		t.Error("expected #0000ff80, got", value)
+	}
+}
+
+func TestMixErrors(t *testing.T) {
+	red := NewColor(SRGB, [3]float64{1, 0, 0}, 1)
+	if _, err := Mix(red, red, 150, SRGB, ""); err == nil {
+		t.Error("expected an error for a percentage above 100")
+	}
+	if _, err := Mix(red, red, 50, DisplayP3, ""); err == nil {
+		t.Error("expected an error for an unsupported interpolation space")
+	}
+}
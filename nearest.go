@@ -0,0 +1,159 @@
+package webcolors
+
+import (
+	"math"
+	"sort"
+)
+
+// # Nearest named color lookups.
+// #################################################################
+//
+// The named colors of a specification are indexed once, with their CIE Lab
+// and Oklab coordinates, in order of Lab lightness. The metrics of this
+// package are then measured on the coordinates, converting the color looked
+// up once rather than at every comparison, and the Lab metrics only visit the
+// named colors close enough in lightness to possibly be the nearest: each of
+// them is at least proportional to the lightness difference of the colors.
+
+// nameIndexEntry a named color of a specification, with its precomputed Color
+// and its coordinates in CIE Lab and Oklab
+type nameIndexEntry struct {
+	name  string
+	color Color
+	lab   [3]float64
+	oklab [3]float64
+}
+
+// nameIndex Internal helper parsing, once, the opaque named colors of the
+// specification, so that lookups only have to measure distances
+func (s *registeredSpec) nameIndex() []nameIndexEntry {
+	s.indexOnce.Do(func() {
+		entries := []nameIndexEntry{}
+		for hexValue, name := range s.hexToName {
+			c, err := Hex(hexValue).Color()
+			if err != nil || c.Alpha != 1 {
+				continue
+			}
+			entries = append(entries, nameIndexEntry{
+				name:  name,
+				color: c,
+				lab:   c.convert(LabSpace).Channels,
+				oklab: c.convert(OKLabSpace).Channels,
+			})
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			if entries[i].lab[0] != entries[j].lab[0] {
+				return entries[i].lab[0] < entries[j].lab[0]
+			}
+			return entries[i].name < entries[j].name
+		})
+		s.index = entries
+	})
+	return s.index
+}
+
+// NearestName Find the named color of a specification closest to a color under a metric,
+// returning the name and its distance to the color. The alpha channel is ignored and the
+// zero Metric selects CIEDE2000.
+func NearestName(c Color, spec string, metric Metric) (string, float64, error) {
+	entry, distance, err := nearestEntry(c, spec, metric, nil)
+	if err != nil {
+		return "", 0, err
+	}
+	return entry.name, distance, nil
+}
+
+// nearestEntry Internal helper finding the named color of a specification closest to a
+// color under a metric, among those accepted by the filter when it is not nil; ties go
+// to the name first in alphabetical order
+func nearestEntry(c Color, spec string, metric Metric, filter func(Color) bool) (nameIndexEntry, float64, error) {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return nameIndexEntry{}, 0, unsupportedSpecError(spec)
+	}
+	entries := registered.nameIndex()
+	metric = metric.orDefault()
+	var best int
+	var bestDistance float64
+	if metric.coordinates != nil {
+		best, bestDistance = nearestCoordinates(c, entries, metric, filter)
+	} else {
+		best, bestDistance = -1, 0.0
+		for i := range entries {
+			if filter != nil && !filter(entries[i].color) {
+				continue
+			}
+			d := metric.distance(c, entries[i].color)
+			if best < 0 || d < bestDistance || (d == bestDistance && entries[i].name < entries[best].name) {
+				best, bestDistance = i, d
+			}
+		}
+	}
+	if best < 0 {
+		return nameIndexEntry{}, 0, &ColorError{Err: ErrNoNameForValue, Value: string(c.Hex()), Spec: spec}
+	}
+	return entries[best], bestDistance, nil
+}
+
+// nearestCoordinates Internal helper finding the index of the entry closest to a color
+// under a metric with a coordinate form, -1 when the filter accepts none, and its distance. Entries are
+// visited in order of their lightness difference to the color, stopping once that difference
+// alone makes them farther than the closest entry so far.
+func nearestCoordinates(c Color, entries []nameIndexEntry, m Metric, filter func(Color) bool) (int, float64) {
+	best, bestDistance := -1, 0.0
+	if len(entries) == 0 {
+		return best, bestDistance
+	}
+	lab := c.convert(LabSpace).Channels
+	query := lab
+	if m.space == OKLabSpace {
+		query = c.convert(OKLabSpace).Channels
+	}
+	scale := 0.0
+	if m.lightnessScale != nil {
+		scale = m.lightnessScale(lab[0], entries[0].lab[0], entries[len(entries)-1].lab[0])
+	}
+	above := sort.Search(len(entries), func(i int) bool { return entries[i].lab[0] >= lab[0] })
+	below := above - 1
+	for below >= 0 || above < len(entries) {
+		var i int
+		if above >= len(entries) || (below >= 0 && lab[0]-entries[below].lab[0] <= entries[above].lab[0]-lab[0]) {
+			i, below = below, below-1
+		} else {
+			i, above = above, above+1
+		}
+		if best >= 0 && scale*math.Abs(entries[i].lab[0]-lab[0]) > bestDistance {
+			break
+		}
+		if filter != nil && !filter(entries[i].color) {
+			continue
+		}
+		coordinates := entries[i].lab
+		if m.space == OKLabSpace {
+			coordinates = entries[i].oklab
+		}
+		d := m.coordinates(query, coordinates)
+		if best < 0 || d < bestDistance || (d == bestDistance && entries[i].name < entries[best].name) {
+			best, bestDistance = i, d
+		}
+	}
+	return best, bestDistance
+}
+
+// HexToNearestName Find the named color of a specification closest to a hexadecimal color value under a metric
+func HexToNearestName(hexValue string, spec string, metric Metric) (string, float64, error) {
+	c, err := Hex(hexValue).Color()
+	if err != nil {
+		return "", 0, err
+	}
+	return NearestName(c, spec, metric)
+}
+
+// RGBToNearestName Find the named color of a specification closest to an integer rgb triplet under a metric
+func RGBToNearestName(rgbTriplet []int, spec string, metric Metric) (string, float64, error) {
+	rgb, err := IntegerRGBFromSlice(rgbTriplet)
+	if err != nil {
+		return "", 0, err
+	}
+	return NearestName(rgb.Color(), spec, metric)
+}
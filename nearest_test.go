@@ -0,0 +1,61 @@
+package webcolors
+
+import "testing"
+
+func TestNearestName(t *testing.T) {
+	for _, metric := range []Metric{EuclideanRGB, Redmean, CIE76, CIEDE2000, {}} {
+		name, distance, err := NearestName(IntegerRGB{254, 0, 1}.Color(), "css3", metric)
+		if err != nil || name != "red" || distance <= 0 {
+			t.Error("expected red, got", name, distance, err)
+		}
+	}
+}
+
+func TestNearestNameExact(t *testing.T) {
+	name, distance, _ := HexToNearestName("#daa520", "css3", CIEDE2000)
+	if name != "goldenrod" || distance != 0 {
+		t.Error("expected goldenrod at 0, got", name, distance)
+	}
+}
+
+func TestRGBToNearestName(t *testing.T) {
+	name, _, _ := RGBToNearestName([]int{250, 128, 112}, "css3", EuclideanRGB)
+	if name != "salmon" {
+		t.Error("expected salmon, got", name)
+	}
+	if _, _, err := RGBToNearestName([]int{0, 0, 0}, "css9", Metric{}); err == nil {
+		t.Error("expected an error for css9")
+	}
+}
+
+func TestCIE76(t *testing.T) {
+	value := CIE76.Distance(IntegerRGB{0, 0, 0}.Color(), IntegerRGB{255, 255, 255}.Color())
+	if value < 99.999 || value > 100.001 {
+		t.Error("expected 100, got", value)
+	}
+}
+
+func TestNearestNameMatchesExhaustiveSearch(t *testing.T) {
+	registered, _ := lookupSpec(CSS4)
+	entries := registered.nameIndex()
+	for _, metric := range []Metric{CIE76, CIE94, CIE94Textiles, CIEDE2000, CMC(2, 1), DeltaEOK} {
+		for r := 0; r < 256; r += 51 {
+			for g := 0; g < 256; g += 51 {
+				for b := 0; b < 256; b += 51 {
+					c := IntegerRGB{r, g, b}.Color()
+					expected, expectedDistance := "", 0.0
+					for _, entry := range entries {
+						d := metric.Distance(c, entry.color)
+						if expected == "" || d < expectedDistance || (d == expectedDistance && entry.name < expected) {
+							expected, expectedDistance = entry.name, d
+						}
+					}
+					name, distance, _ := NearestName(c, CSS4, metric)
+					if name != expected || distance != expectedDistance {
+						t.Error(c.Hex(), "expected", expected, expectedDistance, "got", name, distance)
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package webcolors
+
+import (
+	"strconv"
+)
+
+// # Palette distinguishability.
+// #################################################################
+
+// DefaultPaletteThreshold the CIEDE2000 distance under which CheckPalette reports
+// two colors as too close, unless another threshold is given
+const DefaultPaletteThreshold = 10
+
+// PaletteOptions options controlling CheckPalette
+type PaletteOptions struct {
+	// Threshold the distance under which two colors are too close to distinguish,
+	// DefaultPaletteThreshold when zero
+	Threshold float64
+	// Metric the distance between colors, CIEDE2000 when zero
+	Metric Metric
+	// Kinds the color vision deficiencies simulated besides NormalVision, CVDKinds when nil
+	Kinds []CVDKind
+	// Severity the severity of the simulated deficiencies, 1 when zero
+	Severity float64
+	// Spec the specification color names are looked up in, CSS4 when empty
+	Spec string
+}
+
+// ConfusablePair two colors of a palette too close to distinguish under a vision
+type ConfusablePair struct {
+	// A, B the indexes of the colors in the palette, A < B
+	A, B int
+	// Vision NormalVision or the simulated color vision deficiency
+	Vision CVDKind
+	// Distance the distance of the colors as seen under the vision
+	Distance float64
+}
+
+// CheckPalette Report the pairs of colors of a palette that are too close to distinguish,
+// under normal vision and under each simulated color vision deficiency. Colors may be
+// given in any representation ToColor accepts, such as names, hex values or triplets;
+// alpha is ignored. Pairs are listed by vision, in the order of the options, then by index.
+func CheckPalette(colors []interface{}, opts PaletteOptions) ([]ConfusablePair, error) {
+	if opts.Threshold == 0 {
+		opts.Threshold = DefaultPaletteThreshold
+	}
+	if opts.Kinds == nil {
+		opts.Kinds = CVDKinds
+	}
+	if opts.Severity == 0 {
+		opts.Severity = 1
+	}
+	if opts.Spec == "" {
+		opts.Spec = CSS4
+	}
+	if opts.Threshold < 0 {
+		return []ConfusablePair{}, invalidValueError(strconv.FormatFloat(opts.Threshold, 'g', -1, 64), "a palette threshold of 0 or more")
+	}
+	palette := make([]Color, len(colors))
+	for i, v := range colors {
+		c, err := ToColor(v, opts.Spec)
+		if err != nil {
+			return []ConfusablePair{}, err
+		}
+		if c.IsCurrentColor() {
+			return []ConfusablePair{}, currentColorError()
+		}
+		c.Alpha = 1
+		palette[i] = c
+	}
+	pairs := []ConfusablePair{}
+	for _, kind := range append([]CVDKind{NormalVision}, opts.Kinds...) {
+		seen := make([]Color, len(palette))
+		for i, c := range palette {
+			simulated, err := SimulateCVDColor(c, kind, opts.Severity, "")
+			if err != nil {
+				return []ConfusablePair{}, err
+			}
+			seen[i] = simulated
+		}
+		for a := range seen {
+			for b := a + 1; b < len(seen); b++ {
+				if d := opts.Metric.Distance(seen[a], seen[b]); d < opts.Threshold {
+					pairs = append(pairs, ConfusablePair{A: a, B: b, Vision: kind, Distance: d})
+				}
+			}
+		}
+	}
+	return pairs, nil
+}
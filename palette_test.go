@@ -0,0 +1,46 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestCheckPalette(t *testing.T) {
+	palette := []interface{}{"red", "green", "#0000ff", []int{255, 128, 0}, "orange"}
+	value, _ := CheckPalette(palette, PaletteOptions{Kinds: []CVDKind{Protanopia}})
+	expected := []ConfusablePair{
+		{A: 0, B: 1, Vision: Protanopia},
+		{A: 3, B: 4, Vision: Protanopia},
+	}
+	if len(value) != len(expected) {
+		t.Fatal("expected", expected, "got", value)
+	}
+	for i := range value {
+		if value[i].A != expected[i].A || value[i].B != expected[i].B || value[i].Vision != expected[i].Vision {
+			t.Error("expected", expected[i], "got", value[i])
+		}
+		if value[i].Distance >= DefaultPaletteThreshold {
+			t.Error("expected a distance under the threshold, got", value[i].Distance)
+		}
+	}
+}
+
+func TestCheckPaletteNormalVision(t *testing.T) {
+	palette := []interface{}{"#336699", Hex("#336698"), IntegerRGB{255, 255, 255}}
+	value, _ := CheckPalette(palette, PaletteOptions{Kinds: []CVDKind{}, Threshold: 1})
+	if len(value) != 1 || value[0].A != 0 || value[0].B != 1 || value[0].Vision != NormalVision {
+		t.Error("expected #336699 and #336698 to be confusable, got", value)
+	}
+}
+
+func TestCheckPaletteErrors(t *testing.T) {
+	if _, err := CheckPalette([]interface{}{"red", "notacolor"}, PaletteOptions{}); err == nil {
+		t.Error("expected an error for an unknown color")
+	}
+	if _, err := CheckPalette([]interface{}{"red"}, PaletteOptions{Threshold: -1}); err == nil {
+		t.Error("expected an error for a negative threshold")
+	}
+	if _, err := CheckPalette([]interface{}{"red", "currentcolor"}, PaletteOptions{}); !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for currentcolor, got", err)
+	}
+}
@@ -0,0 +1,780 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// # Parsing of CSS <color> values.
+// #################################################################
+
+// currentColorSpace the pseudo color space of CurrentColor
+const currentColorSpace Space = "currentcolor"
+
+// CurrentColor the Color ParseColor returns for the currentcolor keyword.
+//
+// It stands for the value of the CSS color property of the element
+// the value applies to, and carries no channels of its own.
+var CurrentColor = Color{Space: currentColorSpace}
+
+// IsCurrentColor Report whether the color is the currentcolor keyword
+func (c Color) IsCurrentColor() bool {
+	return c.Space == currentColorSpace
+}
+
+// currentColorError Internal helper building the error of currentcolor given where a color
+// with a value of its own is needed
+func currentColorError() error {
+	return invalidValueError("currentcolor", "a color with a value of its own")
+}
+
+// SyntaxError a syntax error in a CSS color value, reported at a byte offset of the input
+type SyntaxError struct {
+	Input  string
+	Offset int
+	Msg    string
+	// Err the underlying error, if any, such as a *ColorError for unknown color names
+	Err error
+}
+
+func (e *SyntaxError) Error() string {
+	return "invalid color " + strconv.Quote(e.Input) + " at offset " + strconv.Itoa(e.Offset) + ": " + e.Msg
+}
+
+// Unwrap Return the underlying error, if any, for errors.Is and errors.As
+func (e *SyntaxError) Unwrap() error {
+	return e.Err
+}
+
+// ParseColor Parse a CSS <color> value such as "#f00a", "rgb(255 0 0 / 50%)"
+// or "hsl(120deg 100% 50%)".
+//
+// The value follows the CSS Color Level 4 grammar for hexadecimal colors, the
+// rgb(), rgba(), hsl() and hsla() functions, in both their comma-separated
+// and space-separated forms, the hwb(), lab(), lch(), oklab() and oklch()
+// functions, and the color() function with any of the PredefinedSpaces.
+// Colors given in lab(), lch(), oklab(), oklch() and color() are returned
+// in the matching color space. All the color functions also accept the
+// relative color syntax of CSS Color Level 5, such as "oklch(from #336699
+// calc(l + 0.1) c h)", with calc() expressions in place of any channel; use an
+// Evaluator to resolve var() references. Color names are looked up in the table
+// of the given specification; the transparent and currentcolor keywords are only
+// recognized for specifications defining them. Syntax errors are reported as a
+// *SyntaxError.
+func ParseColor(s string, spec string) (Color, error) {
+	if _, ok := lookupSpec(spec); !ok {
+		return Color{}, unsupportedSpecError(spec)
+	}
+	toks, err := tokenize(s)
+	if err != nil {
+		return Color{}, err
+	}
+	p := &parser{input: s, toks: toks, spec: spec}
+	c, err := p.parseColor()
+	if err != nil {
+		return Color{}, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return Color{}, p.errorAt(t.offset, "unexpected trailing input")
+	}
+	return c, nil
+}
+
+// specHasColorKeywords Internal helper reporting whether a specification defines
+// the transparent and currentcolor keywords
+func specHasColorKeywords(spec string) bool {
+	registered, ok := lookupSpec(spec)
+	return ok && registered.colorKeywords
+}
+
+// # Tokenizer.
+// #################################################################
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokFunction
+	tokHash
+	tokNumber
+	tokPercentage
+	tokDimension
+	tokComma
+	tokSlash
+	tokOpenParen
+	tokCloseParen
+	tokDelim
+)
+
+// token a CSS token; text holds the name of identifiers and functions, the digits of
+// hashes, the unit of dimensions and the character of delimiters
+type token struct {
+	kind   tokenKind
+	text   string
+	value  float64
+	offset int
+}
+
+func isNameStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || c >= 0x80
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || isDigit(c) || c == '-'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+// startsIdent Internal helper reporting whether an identifier starts at s[i:]
+func startsIdent(s string, i int) bool {
+	if i >= len(s) {
+		return false
+	}
+	if s[i] == '-' {
+		return i+1 < len(s) && (isNameStart(s[i+1]) || s[i+1] == '-')
+	}
+	return isNameStart(s[i])
+}
+
+// startsNumber Internal helper reporting whether a number starts at s[i:]
+func startsNumber(s string, i int) bool {
+	if i < len(s) && (s[i] == '+' || s[i] == '-') {
+		i++
+	}
+	if i < len(s) && isDigit(s[i]) {
+		return true
+	}
+	return i+1 < len(s) && s[i] == '.' && isDigit(s[i+1])
+}
+
+// consumeName Internal helper returning the end of the name starting at s[i:]
+func consumeName(s string, i int) int {
+	for i < len(s) && isNameChar(s[i]) {
+		i++
+	}
+	return i
+}
+
+// consumeNumber Internal helper returning the end of the number starting at s[i:]
+func consumeNumber(s string, i int) int {
+	if s[i] == '+' || s[i] == '-' {
+		i++
+	}
+	for i < len(s) && isDigit(s[i]) {
+		i++
+	}
+	if i+1 < len(s) && s[i] == '.' && isDigit(s[i+1]) {
+		i++
+		for i < len(s) && isDigit(s[i]) {
+			i++
+		}
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		j := i + 1
+		if j < len(s) && (s[j] == '+' || s[j] == '-') {
+			j++
+		}
+		if j < len(s) && isDigit(s[j]) {
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			i = j
+		}
+	}
+	return i
+}
+
+// tokenize Internal helper splitting a CSS value into tokens, dropping whitespace
+func tokenize(s string) ([]token, error) {
+	toks := []token{}
+	i := 0
+	for i < len(s) {
+		c := s[i]
+		start := i
+		switch {
+		case isSpace(c):
+			i++
+			continue
+		case startsNumber(s, i):
+			i = consumeNumber(s, i)
+			value, err := strconv.ParseFloat(s[start:i], 64)
+			if err != nil {
+				return nil, &SyntaxError{Input: s, Offset: start, Msg: "invalid number"}
+			}
+			switch {
+			case i < len(s) && s[i] == '%':
+				i++
+				toks = append(toks, token{kind: tokPercentage, value: value, offset: start})
+			case startsIdent(s, i):
+				end := consumeName(s, i)
+				toks = append(toks, token{kind: tokDimension, text: s[i:end], value: value, offset: start})
+				i = end
+			default:
+				toks = append(toks, token{kind: tokNumber, value: value, offset: start})
+			}
+			continue
+		case startsIdent(s, i):
+			i = consumeName(s, i)
+			if i < len(s) && s[i] == '(' {
+				toks = append(toks, token{kind: tokFunction, text: s[start:i], offset: start})
+				i++
+			} else {
+				toks = append(toks, token{kind: tokIdent, text: s[start:i], offset: start})
+			}
+			continue
+		case c == '#':
+			i = consumeName(s, i+1)
+			if i == start+1 {
+				return nil, &SyntaxError{Input: s, Offset: start, Msg: "expected hexadecimal digits after '#'", Err: &ColorError{Err: ErrInvalidHex, Value: "#"}}
+			}
+			toks = append(toks, token{kind: tokHash, text: s[start+1 : i], offset: start})
+			continue
+		case c == ',':
+			toks = append(toks, token{kind: tokComma, offset: start})
+		case c == '/':
+			toks = append(toks, token{kind: tokSlash, offset: start})
+		case c == '(':
+			toks = append(toks, token{kind: tokOpenParen, offset: start})
+		case c == ')':
+			toks = append(toks, token{kind: tokCloseParen, offset: start})
+		case c == '+' || c == '-' || c == '*':
+			toks = append(toks, token{kind: tokDelim, text: string(c), offset: start})
+		default:
+			return nil, &SyntaxError{Input: s, Offset: start, Msg: "unexpected character " + strconv.QuoteRune(rune(c))}
+		}
+		i++
+	}
+	return toks, nil
+}
+
+// # Parser.
+// #################################################################
+
+type parser struct {
+	input string
+	toks  []token
+	pos   int
+	spec  string
+	// keywords the channel keywords of the relative color being parsed, if any
+	keywords map[string]float64
+}
+
+// peek Internal helper returning the next token without consuming it
+func (p *parser) peek() token {
+	if p.pos < len(p.toks) {
+		return p.toks[p.pos]
+	}
+	return token{kind: tokEOF, offset: len(p.input)}
+}
+
+// next Internal helper consuming the next token
+func (p *parser) next() token {
+	t := p.peek()
+	if p.pos < len(p.toks) {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorAt(offset int, msg string) error {
+	return &SyntaxError{Input: p.input, Offset: offset, Msg: msg}
+}
+
+// hexErrorAt Internal helper building the syntax error of a malformed hexadecimal color token,
+// wrapping an ErrInvalidHex error
+func (p *parser) hexErrorAt(t token, msg string) error {
+	return &SyntaxError{Input: p.input, Offset: t.offset, Msg: msg, Err: &ColorError{Err: ErrInvalidHex, Value: "#" + t.text}}
+}
+
+// parseColor Internal helper parsing a single <color>
+func (p *parser) parseColor() (Color, error) {
+	t := p.next()
+	switch t.kind {
+	case tokHash:
+		return p.hexColor(t)
+	case tokIdent:
+		return p.keywordColor(t)
+	case tokFunction:
+		return p.functionColor(t)
+	}
+	return Color{}, p.errorAt(t.offset, "expected a color")
+}
+
+// hexColor Internal helper parsing a 3, 4, 6 or 8 digit hexadecimal color
+func (p *parser) hexColor(t token) (Color, error) {
+	digits := t.text
+	switch len(digits) {
+	case 3, 4:
+		expanded := ""
+		for i := range digits {
+			expanded += strings.Repeat(digits[i:i+1], 2)
+		}
+		digits = expanded
+	case 6, 8:
+	default:
+		return Color{}, p.hexErrorAt(t, "a hexadecimal color needs 3, 4, 6 or 8 digits")
+	}
+	values := []float64{}
+	for i := 0; i < len(digits); i += 2 {
+		v, err := strconv.ParseUint(digits[i:i+2], 16, 8)
+		if err != nil {
+			return Color{}, p.hexErrorAt(t, "invalid hexadecimal digits")
+		}
+		values = append(values, float64(v)/255)
+	}
+	c := Color{Space: SRGB, Channels: [3]float64{values[0], values[1], values[2]}, Alpha: 1}
+	if len(values) == 4 {
+		c.Alpha = values[3]
+	}
+	return c, nil
+}
+
+// keywordColor Internal helper resolving a color keyword
+func (p *parser) keywordColor(t token) (Color, error) {
+	name := strings.ToLower(t.text)
+	if specHasColorKeywords(p.spec) {
+		switch name {
+		case "transparent":
+			return Color{Space: SRGB}, nil
+		case "currentcolor":
+			return CurrentColor, nil
+		}
+	}
+	hx, err := NameToHex(name, p.spec)
+	if err != nil {
+		return Color{}, &SyntaxError{Input: p.input, Offset: t.offset, Msg: "unknown color name " + strconv.Quote(t.text) + " in " + p.spec, Err: err}
+	}
+	return Hex(hx).Color()
+}
+
+// functionColor Internal helper parsing a color function such as rgb()
+func (p *parser) functionColor(t token) (Color, error) {
+	name := strings.ToLower(t.text)
+	channels, ok := relativeChannels[name]
+	if name == "var" {
+		return Color{}, p.errorAt(t.offset, "var() references need an Evaluator")
+	} else if !ok {
+		return Color{}, p.errorAt(t.offset, "unsupported color function "+t.text+"()")
+	}
+	// channel keywords are only bound within the relative color defining them
+	saved := p.keywords
+	defer func() { p.keywords = saved }()
+	p.keywords = nil
+	origin, err := p.parseOrigin()
+	if err != nil {
+		return Color{}, err
+	}
+	if name == "color" {
+		return p.predefinedFunction(origin)
+	}
+	if origin != nil {
+		p.bindChannels(*origin, channels)
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return Color{}, err
+	}
+	if origin != nil {
+		if err := p.relativeArgs(&args, *origin); err != nil {
+			return Color{}, err
+		}
+	}
+	switch name {
+	case "rgb", "rgba":
+		return p.rgbFunction(args)
+	case "hsl", "hsla":
+		return p.hslFunction(args)
+	case "hwb":
+		return p.hwbFunction(args)
+	case "lab":
+		return p.labFunction(args, LabSpace, 100, 125)
+	case "oklab":
+		return p.labFunction(args, OKLabSpace, 1, 0.4)
+	case "lch":
+		return p.lchFunction(args, LCHSpace, 100, 150)
+	default:
+		return p.lchFunction(args, OKLCHSpace, 1, 0.4)
+	}
+}
+
+type componentKind int
+
+const (
+	compNumber componentKind = iota
+	compPercentage
+	compAngle
+	compNone
+)
+
+// component a single argument of a color function; angles are held in degrees
+type component struct {
+	kind   componentKind
+	value  float64
+	offset int
+}
+
+// funcArgs the arguments of a color function
+type funcArgs struct {
+	channels []component
+	alpha    *component
+	// legacy is set for the comma-separated syntax, comma holds the offset of its first comma
+	legacy bool
+	comma  int
+	end    int
+}
+
+// parseArgs Internal helper parsing the arguments of a color function up to its closing parenthesis
+func (p *parser) parseArgs() (funcArgs, error) {
+	args := funcArgs{}
+	first, err := p.parseComponent()
+	if err != nil {
+		return args, err
+	}
+	args.channels = append(args.channels, first)
+	if p.peek().kind == tokComma {
+		args.legacy = true
+		args.comma = p.peek().offset
+		for p.peek().kind == tokComma {
+			p.next()
+			c, err := p.parseComponent()
+			if err != nil {
+				return args, err
+			}
+			args.channels = append(args.channels, c)
+		}
+		if len(args.channels) > 4 {
+			return args, p.errorAt(args.channels[4].offset, "too many arguments")
+		}
+		if len(args.channels) == 4 {
+			args.alpha = &args.channels[3]
+			args.channels = args.channels[:3]
+		}
+	} else {
+		for k := p.peek().kind; k != tokSlash && k != tokCloseParen && k != tokEOF; k = p.peek().kind {
+			c, err := p.parseComponent()
+			if err != nil {
+				return args, err
+			}
+			args.channels = append(args.channels, c)
+		}
+		if p.peek().kind == tokSlash {
+			p.next()
+			c, err := p.parseComponent()
+			if err != nil {
+				return args, err
+			}
+			args.alpha = &c
+		}
+	}
+	t := p.next()
+	if t.kind != tokCloseParen {
+		return args, p.errorAt(t.offset, "expected ')'")
+	}
+	args.end = t.offset
+	if len(args.channels) > 3 {
+		return args, p.errorAt(args.channels[3].offset, "too many arguments")
+	}
+	if len(args.channels) < 3 {
+		return args, p.errorAt(t.offset, "expected 3 color channels, got "+strconv.Itoa(len(args.channels)))
+	}
+	if args.legacy {
+		for _, c := range args.allComponents() {
+			if c.kind == compNone {
+				return args, p.errorAt(c.offset, "none is not allowed in the comma-separated syntax")
+			}
+		}
+	}
+	return args, nil
+}
+
+// allComponents Internal helper returning the channels followed by the alpha, if any
+func (a funcArgs) allComponents() []component {
+	if a.alpha == nil {
+		return a.channels
+	}
+	return append(append([]component{}, a.channels...), *a.alpha)
+}
+
+// parseComponent Internal helper parsing a number, percentage, angle, the none keyword,
+// a channel keyword of a relative color or a calc() expression
+func (p *parser) parseComponent() (component, error) {
+	t := p.next()
+	switch t.kind {
+	case tokFunction:
+		return p.calcFunction(t)
+	case tokNumber:
+		return component{kind: compNumber, value: t.value, offset: t.offset}, nil
+	case tokPercentage:
+		return component{kind: compPercentage, value: t.value, offset: t.offset}, nil
+	case tokDimension:
+		if deg, ok := angleToDegrees(t.value, t.text); ok {
+			return component{kind: compAngle, value: deg, offset: t.offset}, nil
+		}
+		return component{}, p.errorAt(t.offset, "unknown unit "+strconv.Quote(t.text))
+	case tokIdent:
+		if strings.EqualFold(t.text, "none") {
+			return component{kind: compNone, offset: t.offset}, nil
+		}
+		if value, ok := p.keywords[strings.ToLower(t.text)]; ok {
+			return component{kind: compNumber, value: value, offset: t.offset}, nil
+		}
+	case tokEOF:
+		return component{}, p.errorAt(t.offset, "unexpected end of input")
+	}
+	return component{}, p.errorAt(t.offset, "expected a number, percentage or angle")
+}
+
+// angleToDegrees Internal helper converting an angle in a CSS angle unit to degrees
+func angleToDegrees(value float64, unit string) (float64, bool) {
+	switch strings.ToLower(unit) {
+	case "deg":
+		return value, true
+	case "rad":
+		return value * 180 / math.Pi, true
+	case "grad":
+		return value * 0.9, true
+	case "turn":
+		return value * 360, true
+	}
+	return 0, false
+}
+
+// alphaValue Internal helper resolving the alpha argument of a color function, 1 when omitted
+func (p *parser) alphaValue(args funcArgs) (float64, error) {
+	if args.alpha == nil {
+		return 1, nil
+	}
+	switch a := *args.alpha; a.kind {
+	case compNumber:
+		return clampUnit(a.value), nil
+	case compPercentage:
+		return clampUnit(a.value / 100), nil
+	case compNone:
+		return 0, nil
+	default:
+		return 0, p.errorAt(a.offset, "expected a number or percentage for alpha")
+	}
+}
+
+// numberOrPercent Internal helper resolving a channel where a number n means n%,
+// returning a fraction of 1
+func (p *parser) numberOrPercent(c component) (float64, error) {
+	switch c.kind {
+	case compNumber, compPercentage:
+		return c.value / 100, nil
+	case compNone:
+		return 0, nil
+	}
+	return 0, p.errorAt(c.offset, "expected a number or percentage")
+}
+
+// hue Internal helper resolving a hue channel to degrees
+func (p *parser) hue(c component) (float64, error) {
+	switch c.kind {
+	case compNumber, compAngle:
+		return c.value, nil
+	case compNone:
+		return 0, nil
+	}
+	return 0, p.errorAt(c.offset, "expected a number or angle for hue")
+}
+
+// rgbFunction Internal helper evaluating rgb() and rgba()
+func (p *parser) rgbFunction(args funcArgs) (Color, error) {
+	var channels [3]float64
+	for i, c := range args.channels {
+		switch c.kind {
+		case compNumber:
+			channels[i] = clampUnit(c.value / 255)
+		case compPercentage:
+			channels[i] = clampUnit(c.value / 100)
+		case compNone:
+			channels[i] = 0
+		default:
+			return Color{}, p.errorAt(c.offset, "expected a number or percentage")
+		}
+		if args.legacy && c.kind != args.channels[0].kind {
+			return Color{}, p.errorAt(c.offset, "cannot mix numbers and percentages in the comma-separated syntax")
+		}
+	}
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{Space: SRGB, Channels: channels, Alpha: alpha}, nil
+}
+
+// hslFunction Internal helper evaluating hsl() and hsla()
+func (p *parser) hslFunction(args funcArgs) (Color, error) {
+	hue, err := p.hue(args.channels[0])
+	if err != nil {
+		return Color{}, err
+	}
+	if args.legacy {
+		for _, c := range args.channels[1:] {
+			if c.kind != compPercentage {
+				return Color{}, p.errorAt(c.offset, "expected a percentage in the comma-separated syntax")
+			}
+		}
+	}
+	sat, err := p.numberOrPercent(args.channels[1])
+	if err != nil {
+		return Color{}, err
+	}
+	light, err := p.numberOrPercent(args.channels[2])
+	if err != nil {
+		return Color{}, err
+	}
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	rgb := hslToSRGB(hue, math.Max(sat, 0), clampUnit(light))
+	return Color{Space: SRGB, Channels: clampChannels(rgb), Alpha: alpha}, nil
+}
+
+// hwbFunction Internal helper evaluating hwb()
+func (p *parser) hwbFunction(args funcArgs) (Color, error) {
+	if args.legacy {
+		return Color{}, p.errorAt(args.comma, "hwb() does not accept the comma-separated syntax")
+	}
+	hue, err := p.hue(args.channels[0])
+	if err != nil {
+		return Color{}, err
+	}
+	white, err := p.numberOrPercent(args.channels[1])
+	if err != nil {
+		return Color{}, err
+	}
+	black, err := p.numberOrPercent(args.channels[2])
+	if err != nil {
+		return Color{}, err
+	}
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	rgb := hwbToSRGB(hue, clampUnit(white), clampUnit(black))
+	return Color{Space: SRGB, Channels: clampChannels(rgb), Alpha: alpha}, nil
+}
+
+// predefinedFunction Internal helper evaluating color(), whose channels are left
+// unclamped so that colors outside the sRGB gamut keep their values
+func (p *parser) predefinedFunction(origin *Color) (Color, error) {
+	t := p.next()
+	if t.kind != tokIdent {
+		return Color{}, p.errorAt(t.offset, "expected a color space")
+	}
+	space, ok := predefinedSpaceNames[strings.ToLower(t.text)]
+	if !ok {
+		return Color{}, p.errorAt(t.offset, "unsupported color space "+strconv.Quote(t.text))
+	}
+	if origin != nil {
+		names := [3]string{"r", "g", "b"}
+		if space == XYZD50 || space == XYZD65 {
+			names = [3]string{"x", "y", "z"}
+		}
+		p.bindChannels(*origin, channelKeywords{space: space, names: names, scale: 1})
+	}
+	args, err := p.parseArgs()
+	if err != nil {
+		return Color{}, err
+	}
+	if origin != nil {
+		if err := p.relativeArgs(&args, *origin); err != nil {
+			return Color{}, err
+		}
+	}
+	if args.legacy {
+		return Color{}, p.errorAt(args.comma, "expected space-separated arguments")
+	}
+	var channels [3]float64
+	for i, c := range args.channels {
+		if channels[i], err = p.scaled(c, 1); err != nil {
+			return Color{}, err
+		}
+	}
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{Space: space, Channels: channels, Alpha: alpha}, nil
+}
+
+// clampChannels Internal helper clamping every channel to the range 0-1 inclusive
+func clampChannels(channels [3]float64) [3]float64 {
+	return [3]float64{clampUnit(channels[0]), clampUnit(channels[1]), clampUnit(channels[2])}
+}
+
+// scaled Internal helper resolving a channel where a percentage is relative to ref
+func (p *parser) scaled(c component, ref float64) (float64, error) {
+	switch c.kind {
+	case compNumber:
+		return c.value, nil
+	case compPercentage:
+		return c.value / 100 * ref, nil
+	case compNone:
+		return 0, nil
+	}
+	return 0, p.errorAt(c.offset, "expected a number or percentage")
+}
+
+// labFunction Internal helper evaluating lab() and oklab(), whose lightness is
+// relative to lightRef and whose a and b axes are relative to axisRef
+func (p *parser) labFunction(args funcArgs, space Space, lightRef, axisRef float64) (Color, error) {
+	if args.legacy {
+		return Color{}, p.errorAt(args.comma, "expected space-separated arguments")
+	}
+	var channels [3]float64
+	for i, c := range args.channels {
+		ref := axisRef
+		if i == 0 {
+			ref = lightRef
+		}
+		v, err := p.scaled(c, ref)
+		if err != nil {
+			return Color{}, err
+		}
+		channels[i] = v
+	}
+	channels[0] = clampUnit(channels[0]/lightRef) * lightRef
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	return Color{Space: space, Channels: channels, Alpha: alpha}, nil
+}
+
+// lchFunction Internal helper evaluating lch() and oklch(), whose lightness is
+// relative to lightRef and whose chroma is relative to chromaRef
+func (p *parser) lchFunction(args funcArgs, space Space, lightRef, chromaRef float64) (Color, error) {
+	if args.legacy {
+		return Color{}, p.errorAt(args.comma, "expected space-separated arguments")
+	}
+	light, err := p.scaled(args.channels[0], lightRef)
+	if err != nil {
+		return Color{}, err
+	}
+	chroma, err := p.scaled(args.channels[1], chromaRef)
+	if err != nil {
+		return Color{}, err
+	}
+	hue, err := p.hue(args.channels[2])
+	if err != nil {
+		return Color{}, err
+	}
+	alpha, err := p.alphaValue(args)
+	if err != nil {
+		return Color{}, err
+	}
+	channels := [3]float64{clampUnit(light/lightRef) * lightRef, math.Max(chroma, 0), normalizeHue(hue)}
+	return Color{Space: space, Channels: channels, Alpha: alpha}, nil
+}
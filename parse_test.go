@@ -0,0 +1,78 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseColor(t *testing.T) {
+	cases := map[string]string{
+		"#f00":                    "#ff0000",
+		"#F00A":                   "#ff0000aa",
+		"red":                     "#ff0000",
+		"rgb(255, 0, 0)":          "#ff0000",
+		"rgba(100%, 0%, 0%, .5)":  "#ff000080",
+		"rgb(255 0 0 / 50%)":      "#ff000080",
+		"rgb(none 128 0)":         "#008000",
+		"hsl(120deg 100% 25%)":    "#008000",
+		"hsl(0.5turn, 100%, 50%)": "#00ffff",
+		"hwb(0 0% 0%)":            "#ff0000",
+		"hwb(90 60% 60%)":         "#808080",
+	}
+	for input, expected := range cases {
+		value, err := ParseColor(input, "css3")
+		if err != nil {
+			t.Error(input, "unexpected error", err)
+			continue
+		}
+		if value.Hex() != Hex(expected) {
+			t.Error(input, "expected", expected, "got", value.Hex())
+		}
+	}
+}
+
+func TestParseColorAlpha(t *testing.T) {
+	value, _ := ParseColor("rgb(255 0 0 / 50%)", "css3")
+	if value.Alpha != 0.5 {
+		t.Error("expected 0.5, got", value.Alpha)
+	}
+	value, _ = ParseColor("transparent", "css3")
+	if value.Alpha != 0 {
+		t.Error("expected 0, got", value.Alpha)
+	}
+}
+
+func TestParseColorCurrentColor(t *testing.T) {
+	value, _ := ParseColor("currentColor", "css3")
+	if !value.IsCurrentColor() {
+		t.Error("expected currentcolor, got", value)
+	}
+	if _, err := ParseColor("currentcolor", "html4"); err == nil {
+		t.Error("expected an error for currentcolor in html4")
+	}
+}
+
+func TestParseColorSyntaxError(t *testing.T) {
+	cases := map[string]int{
+		"#12":                 0,
+		"rgb(255, 0 0)":       11,
+		"rgb(255, 0%, 0)":     9,
+		"rgb(255 0)":          9,
+		"hsl(120 100% 50% 1)": 17,
+		"hwb(0, 0%, 0%)":      5,
+		"blurple":             0,
+		"red blue":            4,
+		"rgb(1 2 3":           9,
+	}
+	for input, offset := range cases {
+		_, err := ParseColor(input, "css3")
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) {
+			t.Error(input, "expected a syntax error, got", err)
+			continue
+		}
+		if syntaxErr.Offset != offset {
+			t.Error(input, "expected offset", offset, "got", syntaxErr.Offset)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package webcolors
+
+import "math"
+
+// # Predefined color spaces of the CSS color() function.
+// #################################################################
+//
+// https://www.w3.org/TR/css-color-4/#predefined
+
+const (
+	// SRGBLinear sRGB without its transfer function
+	SRGBLinear Space = "srgb-linear"
+	// DisplayP3 the Display P3 color space, with the sRGB transfer function
+	DisplayP3 Space = "display-p3"
+	// A98RGB the Adobe RGB (1998) compatible color space
+	A98RGB Space = "a98-rgb"
+	// ProPhotoRGB the ProPhoto RGB color space, relative to the D50 white point
+	ProPhotoRGB Space = "prophoto-rgb"
+	// Rec2020 the ITU-R BT.2020 color space
+	Rec2020 Space = "rec2020"
+	// XYZD50 the CIE XYZ color space, relative to the D50 white point
+	XYZD50 Space = "xyz-d50"
+	// XYZD65 the CIE XYZ color space, relative to the D65 white point
+	XYZD65 Space = "xyz-d65"
+)
+
+// PredefinedSpaces the color spaces accepted by the CSS color() function, whose
+// channels are the red, green and blue components within 0-1 inclusive for the
+// RGB spaces, and the X, Y and Z components for the XYZ spaces.
+var PredefinedSpaces = []Space{SRGB, SRGBLinear, DisplayP3, A98RGB, ProPhotoRGB, Rec2020, XYZD50, XYZD65}
+
+// predefinedSpaceNames mapping of the lowercase color() space identifiers to color spaces;
+// xyz is an alias of xyz-d65
+var predefinedSpaceNames = map[string]Space{
+	"srgb":         SRGB,
+	"srgb-linear":  SRGBLinear,
+	"display-p3":   DisplayP3,
+	"a98-rgb":      A98RGB,
+	"prophoto-rgb": ProPhotoRGB,
+	"rec2020":      Rec2020,
+	"xyz":          XYZD65,
+	"xyz-d50":      XYZD50,
+	"xyz-d65":      XYZD65,
+}
+
+// isPredefinedSpace Internal helper reporting whether a color space is one of the PredefinedSpaces
+func isPredefinedSpace(space Space) bool {
+	for _, s := range PredefinedSpaces {
+		if s == space {
+			return true
+		}
+	}
+	return false
+}
+
+func init() {
+	spaceConversions[SRGBLinear] = spaceConversion{
+		toXYZ:   linearSRGBToXYZ.mul,
+		fromXYZ: xyzToLinearSRGB.mul,
+	}
+	spaceConversions[DisplayP3] = spaceConversion{
+		toXYZ:   func(c [3]float64) [3]float64 { return linearP3ToXYZ.mul(srgbToLinear(c)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return linearToSRGB(xyzToLinearP3.mul(c)) },
+	}
+	spaceConversions[A98RGB] = spaceConversion{
+		toXYZ:   func(c [3]float64) [3]float64 { return linearA98RGBToXYZ.mul(gammaChannels(c, 563.0/256)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return gammaChannels(xyzToLinearA98RGB.mul(c), 256.0/563) },
+	}
+	spaceConversions[ProPhotoRGB] = spaceConversion{
+		toXYZ:   func(c [3]float64) [3]float64 { return d50ToD65.mul(linearProPhotoToXYZD50.mul(proPhotoToLinear(c))) },
+		fromXYZ: func(c [3]float64) [3]float64 { return linearToProPhoto(xyzD50ToLinearProPhoto.mul(d65ToD50.mul(c))) },
+	}
+	spaceConversions[Rec2020] = spaceConversion{
+		toXYZ:   func(c [3]float64) [3]float64 { return linearRec2020ToXYZ.mul(rec2020ToLinear(c)) },
+		fromXYZ: func(c [3]float64) [3]float64 { return linearToRec2020(xyzToLinearRec2020.mul(c)) },
+	}
+	spaceConversions[XYZD50] = spaceConversion{
+		toXYZ:   d50ToD65.mul,
+		fromXYZ: d65ToD50.mul,
+	}
+	spaceConversions[XYZD65] = spaceConversion{
+		toXYZ:   func(c [3]float64) [3]float64 { return c },
+		fromXYZ: func(c [3]float64) [3]float64 { return c },
+	}
+}
+
+// Conversions between linear-light Display P3 and D65 XYZ
+var (
+	linearP3ToXYZ = matrix3{
+		{608311.0 / 1250200, 189793.0 / 714400, 198249.0 / 1000160},
+		{35783.0 / 156275, 247089.0 / 357200, 198249.0 / 2500400},
+		{0, 32229.0 / 714400, 5220557.0 / 5000800},
+	}
+	xyzToLinearP3 = matrix3{
+		{446124.0 / 178915, -333277.0 / 357830, -72051.0 / 178915},
+		{-14852.0 / 17905, 63121.0 / 35810, 423.0 / 17905},
+		{11844.0 / 330415, -50337.0 / 660830, 316169.0 / 330415},
+	}
+)
+
+// Conversions between linear-light a98-rgb and D65 XYZ
+var (
+	linearA98RGBToXYZ = matrix3{
+		{573536.0 / 994567, 263643.0 / 1420810, 187206.0 / 994567},
+		{591459.0 / 1989134, 6239551.0 / 9945670, 374412.0 / 4972835},
+		{53769.0 / 1989134, 351524.0 / 4972835, 4929758.0 / 4972835},
+	}
+	xyzToLinearA98RGB = matrix3{
+		{1829569.0 / 896150, -506331.0 / 896150, -308931.0 / 896150},
+		{-851781.0 / 878810, 1648619.0 / 878810, 36519.0 / 878810},
+		{16779.0 / 1248040, -147721.0 / 1248040, 1266979.0 / 1248040},
+	}
+)
+
+// Conversions between linear-light ProPhoto RGB and D50 XYZ
+var (
+	linearProPhotoToXYZD50 = matrix3{
+		{0.79776664490064230, 0.13518129740053308, 0.03134773412839220},
+		{0.28807482881940130, 0.71183523424187300, 0.00008993693872564},
+		{0, 0, 0.82510460251046020},
+	}
+	xyzD50ToLinearProPhoto = matrix3{
+		{1.34578688164715830, -0.25557208737979464, -0.05110186497554526},
+		{-0.54463070512490190, 1.50824774284514680, 0.02052744743642139},
+		{0, 0, 1.21196754563894520},
+	}
+)
+
+// Conversions between linear-light rec2020 and D65 XYZ
+var (
+	linearRec2020ToXYZ = matrix3{
+		{63426534.0 / 99577255, 20160776.0 / 139408157, 47086771.0 / 278816314},
+		{26158966.0 / 99577255, 472592308.0 / 697040785, 8267143.0 / 139408157},
+		{0, 19567812.0 / 697040785, 295819943.0 / 278816314},
+	}
+	xyzToLinearRec2020 = matrix3{
+		{30757411.0 / 17917100, -6372589.0 / 17917100, -4539589.0 / 17917100},
+		{-19765991.0 / 29648200, 47925759.0 / 29648200, 467509.0 / 29648200},
+		{792561.0 / 44930125, -1921689.0 / 44930125, 42328811.0 / 44930125},
+	}
+)
+
+// gammaChannels Internal helper raising every channel to a power, extended to negative values
+func gammaChannels(c [3]float64, gamma float64) [3]float64 {
+	var out [3]float64
+	for i, v := range c {
+		out[i] = math.Copysign(math.Pow(math.Abs(v), gamma), v)
+	}
+	return out
+}
+
+// proPhotoToLinear Internal helper undoing the ProPhoto RGB transfer function
+func proPhotoToLinear(c [3]float64) [3]float64 {
+	var linear [3]float64
+	for i, v := range c {
+		if math.Abs(v) <= 16.0/512 {
+			linear[i] = v / 16
+		} else {
+			linear[i] = math.Copysign(math.Pow(math.Abs(v), 1.8), v)
+		}
+	}
+	return linear
+}
+
+// linearToProPhoto Internal helper applying the ProPhoto RGB transfer function
+func linearToProPhoto(linear [3]float64) [3]float64 {
+	var c [3]float64
+	for i, v := range linear {
+		if math.Abs(v) >= 1.0/512 {
+			c[i] = math.Copysign(math.Pow(math.Abs(v), 1/1.8), v)
+		} else {
+			c[i] = 16 * v
+		}
+	}
+	return c
+}
+
+// Constants of the rec2020 transfer function
+const (
+	rec2020Alpha = 1.09929682680944
+	rec2020Beta  = 0.018053968510807
+)
+
+// rec2020ToLinear Internal helper undoing the rec2020 transfer function
+func rec2020ToLinear(c [3]float64) [3]float64 {
+	var linear [3]float64
+	for i, v := range c {
+		if math.Abs(v) < rec2020Beta*4.5 {
+			linear[i] = v / 4.5
+		} else {
+			linear[i] = math.Copysign(math.Pow((math.Abs(v)+rec2020Alpha-1)/rec2020Alpha, 1/0.45), v)
+		}
+	}
+	return linear
+}
+
+// linearToRec2020 Internal helper applying the rec2020 transfer function
+func linearToRec2020(linear [3]float64) [3]float64 {
+	var c [3]float64
+	for i, v := range linear {
+		if math.Abs(v) > rec2020Beta {
+			c[i] = math.Copysign(rec2020Alpha*math.Pow(math.Abs(v), 0.45)-(rec2020Alpha-1), v)
+		} else {
+			c[i] = 4.5 * v
+		}
+	}
+	return c
+}
@@ -0,0 +1,95 @@
+package webcolors
+
+import "testing"
+
+func TestPredefinedMatrices(t *testing.T) {
+	pairs := map[string][2]matrix3{
+		"display-p3":   {linearP3ToXYZ, xyzToLinearP3},
+		"a98-rgb":      {linearA98RGBToXYZ, xyzToLinearA98RGB},
+		"prophoto-rgb": {linearProPhotoToXYZD50, xyzD50ToLinearProPhoto},
+		"rec2020":      {linearRec2020ToXYZ, xyzToLinearRec2020},
+	}
+	for name, pair := range pairs {
+		for i := 0; i < 3; i++ {
+			var unit [3]float64
+			unit[i] = 1
+			value := pair[1].mul(pair[0].mul(unit))
+			for j := range value {
+				if !closeTo(value[j], unit[j], 1e-9) {
+					t.Error(name, "expected the identity, got", value)
+				}
+			}
+		}
+	}
+}
+
+func TestPredefinedWhite(t *testing.T) {
+	for _, space := range PredefinedSpaces[:6] {
+		value := NewColor(space, [3]float64{1, 1, 1}, 1).convert(XYZD65).Channels
+		if !closeTo(value[0], 0.9505, 1e-4) || !closeTo(value[1], 1, 1e-4) || !closeTo(value[2], 1.0891, 1e-4) {
+			t.Error(space, "expected 0.9505 1 1.0891, got", value)
+		}
+	}
+}
+
+func TestSRGBToPredefined(t *testing.T) {
+	red := NewColor(SRGB, [3]float64{1, 0, 0}, 1)
+	expected := map[Space][3]float64{
+		DisplayP3:   {0.9175, 0.2003, 0.1386},
+		A98RGB:      {0.8586, 0, 0},
+		ProPhotoRGB: {0.7022, 0.2757, 0.1035},
+		Rec2020:     {0.792, 0.231, 0.0738},
+		XYZD50:      {0.4361, 0.2225, 0.0139},
+	}
+	for space, channels := range expected {
+		value := red.convert(space).Channels
+		for i := range value {
+			if !closeTo(value[i], channels[i], 1e-4) {
+				t.Error(space, "expected", channels, "got", value)
+			}
+		}
+	}
+}
+
+func TestParsePredefined(t *testing.T) {
+	value, _ := ParseColor("color(display-p3 0.9175 0.2003 0.1386)", CSS3)
+	if value.Space != DisplayP3 || value.Hex() != "#ff0000" {
+		t.Error("expected display-p3 #ff0000, got", value.Space, value.Hex())
+	}
+	value, _ = ParseColor("color(display-p3 1 0 0)", CSS3)
+	if value.Channels != [3]float64{1, 0, 0} {
+		t.Error("expected unclamped channels 1 0 0, got", value.Channels)
+	}
+	value, _ = ParseColor("color(xyz 50% 0.5 none / 50%)", CSS3)
+	if value.Space != XYZD65 || value.Channels != [3]float64{0.5, 0.5, 0} || value.Alpha != 0.5 {
+		t.Error("expected xyz-d65 0.5 0.5 0 / 0.5, got", value)
+	}
+	_, err := ParseColor("color(foo 1 0 0)", CSS3)
+	if _, ok := err.(*SyntaxError); !ok || err.(*SyntaxError).Offset != 6 {
+		t.Error("expected a syntax error at offset 6, got", err)
+	}
+	_, err = ParseColor("color(srgb 1, 0, 0)", CSS3)
+	if err == nil {
+		t.Error("expected an error for the comma-separated syntax")
+	}
+}
+
+func TestFormatPredefined(t *testing.T) {
+	value, _ := ParseColor("color(rec2020 0.5 0.25 1 / 0.5)", CSS3)
+	s, _ := Format(value, NotationColor, FormatOptions{})
+	if s != "color(rec2020 0.5 0.25 1 / 0.5)" {
+		t.Error("expected color(rec2020 0.5 0.25 1 / 0.5), got", s)
+	}
+	s, _ = Format(NewColor(SRGB, [3]float64{1, 0, 0}, 1), NotationColor, FormatOptions{ColorSpace: DisplayP3})
+	if s != "color(display-p3 0.9175 0.2003 0.1386)" {
+		t.Error("expected color(display-p3 0.9175 0.2003 0.1386), got", s)
+	}
+	s, _ = Format(NewColor(LabSpace, [3]float64{100, 0, 0}, 1), NotationColor, FormatOptions{})
+	if s != "color(srgb 1 1 1)" {
+		t.Error("expected color(srgb 1 1 1), got", s)
+	}
+	_, err := Format(NewColor(SRGB, [3]float64{1, 0, 0}, 1), NotationColor, FormatOptions{ColorSpace: LabSpace})
+	if err == nil {
+		t.Error("expected an error for a space that is not predefined")
+	}
+}
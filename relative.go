@@ -0,0 +1,328 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// # Relative colors and calc().
+// #################################################################
+//
+// A relative color such as "rgb(from red r g calc(b + 20))" converts its
+// origin color to the space of the color function, and binds each of the
+// resulting channels, and alpha, to a keyword the arguments may refer to.
+// Channel keywords resolve to plain numbers, on the scale the function gives
+// to numbers: 0-255 for rgb(), 0-100 for the saturation and lightness of
+// hsl(), degrees for hues.
+//
+// https://www.w3.org/TR/css-color-5/#relative-colors
+
+// channelKeywords the channel keywords of a color function
+type channelKeywords struct {
+	space Space
+	names [3]string
+	// scale the factor from the channels of space to the numbers of the function
+	scale float64
+}
+
+// relativeChannels the channel keywords of the supported color functions; those
+// of color() depend on its color space
+var relativeChannels = map[string]channelKeywords{
+	"rgb":   {SRGB, [3]string{"r", "g", "b"}, 255},
+	"rgba":  {SRGB, [3]string{"r", "g", "b"}, 255},
+	"hsl":   {HSLSpace, [3]string{"h", "s", "l"}, 1},
+	"hsla":  {HSLSpace, [3]string{"h", "s", "l"}, 1},
+	"hwb":   {HWBSpace, [3]string{"h", "w", "b"}, 1},
+	"lab":   {LabSpace, [3]string{"l", "a", "b"}, 1},
+	"oklab": {OKLabSpace, [3]string{"l", "a", "b"}, 1},
+	"lch":   {LCHSpace, [3]string{"l", "c", "h"}, 1},
+	"oklch": {OKLCHSpace, [3]string{"l", "c", "h"}, 1},
+	"color": {},
+}
+
+// parseOrigin Internal helper parsing the "from <color>" prefix of a relative color,
+// returning nil when the color is not relative
+func (p *parser) parseOrigin() (*Color, error) {
+	if t := p.peek(); t.kind != tokIdent || !strings.EqualFold(t.text, "from") {
+		return nil, nil
+	}
+	p.next()
+	start := p.peek().offset
+	origin, err := p.parseColor()
+	if err != nil {
+		return nil, err
+	}
+	if origin.IsCurrentColor() {
+		return nil, p.errorAt(start, "currentcolor cannot be the origin of a relative color")
+	}
+	return &origin, nil
+}
+
+// bindChannels Internal helper binding the channel keywords of a function to the origin color
+func (p *parser) bindChannels(origin Color, channels channelKeywords) {
+	ch := origin.convert(channels.space).Channels
+	p.keywords = map[string]float64{"alpha": origin.Alpha}
+	for i, name := range channels.names {
+		p.keywords[name] = ch[i] * channels.scale
+	}
+}
+
+// relativeArgs Internal helper checking the arguments of a relative color, whose
+// alpha defaults to the alpha of the origin color
+func (p *parser) relativeArgs(args *funcArgs, origin Color) error {
+	if args.legacy {
+		return p.errorAt(args.comma, "relative colors need space-separated arguments")
+	}
+	if args.alpha == nil {
+		args.alpha = &component{kind: compNumber, value: origin.Alpha, offset: args.end}
+	}
+	return nil
+}
+
+// calcFunction Internal helper evaluating a calc() expression; t is its function token
+func (p *parser) calcFunction(t token) (component, error) {
+	if !strings.EqualFold(t.text, "calc") {
+		if strings.EqualFold(t.text, "var") {
+			return component{}, p.errorAt(t.offset, "var() references need an Evaluator")
+		}
+		return component{}, p.errorAt(t.offset, "unsupported function "+t.text+"()")
+	}
+	value, err := p.calcSum()
+	if err != nil {
+		return component{}, err
+	}
+	if end := p.next(); end.kind != tokCloseParen {
+		return component{}, p.errorAt(end.offset, "expected ')'")
+	}
+	value.offset = t.offset
+	return value, nil
+}
+
+// calcSum Internal helper evaluating a sum of products, whose terms must have the same type
+func (p *parser) calcSum() (component, error) {
+	left, err := p.calcProduct()
+	if err != nil {
+		return component{}, err
+	}
+	for t := p.peek(); t.kind == tokDelim && (t.text == "+" || t.text == "-"); t = p.peek() {
+		p.next()
+		right, err := p.calcProduct()
+		if err != nil {
+			return component{}, err
+		}
+		if left.kind != right.kind {
+			return component{}, p.errorAt(t.offset, "cannot add "+calcTypeName(left.kind)+" and "+calcTypeName(right.kind))
+		}
+		if t.text == "+" {
+			left.value += right.value
+		} else {
+			left.value -= right.value
+		}
+	}
+	return left, nil
+}
+
+// calcProduct Internal helper evaluating a product, of which at most one factor may have
+// a unit and whose divisors must be numbers
+func (p *parser) calcProduct() (component, error) {
+	left, err := p.calcValue()
+	if err != nil {
+		return component{}, err
+	}
+	for t := p.peek(); t.kind == tokSlash || t.kind == tokDelim && t.text == "*"; t = p.peek() {
+		p.next()
+		right, err := p.calcValue()
+		if err != nil {
+			return component{}, err
+		}
+		if t.kind == tokSlash {
+			if right.kind != compNumber {
+				return component{}, p.errorAt(right.offset, "cannot divide by "+calcTypeName(right.kind))
+			}
+			if right.value == 0 {
+				return component{}, p.errorAt(right.offset, "division by zero")
+			}
+			left.value /= right.value
+			continue
+		}
+		switch {
+		case left.kind == compNumber:
+			left.kind = right.kind
+		case right.kind != compNumber:
+			return component{}, p.errorAt(t.offset, "cannot multiply "+calcTypeName(left.kind)+" by "+calcTypeName(right.kind))
+		}
+		left.value *= right.value
+	}
+	return left, nil
+}
+
+// calcValue Internal helper evaluating a single value of a calc() expression
+func (p *parser) calcValue() (component, error) {
+	t := p.peek()
+	switch t.kind {
+	case tokOpenParen:
+		p.next()
+		value, err := p.calcSum()
+		if err != nil {
+			return component{}, err
+		}
+		if end := p.next(); end.kind != tokCloseParen {
+			return component{}, p.errorAt(end.offset, "expected ')'")
+		}
+		return value, nil
+	case tokIdent:
+		switch strings.ToLower(t.text) {
+		case "pi":
+			p.next()
+			return component{kind: compNumber, value: math.Pi, offset: t.offset}, nil
+		case "e":
+			p.next()
+			return component{kind: compNumber, value: math.E, offset: t.offset}, nil
+		case "none":
+			return component{}, p.errorAt(t.offset, "none is not allowed in calc()")
+		}
+	}
+	return p.parseComponent()
+}
+
+// calcTypeName Internal helper naming the type of a calc() value in error messages
+func calcTypeName(kind componentKind) string {
+	switch kind {
+	case compPercentage:
+		return "a percentage"
+	case compAngle:
+		return "an angle"
+	}
+	return "a number"
+}
+
+// # Evaluator.
+// #################################################################
+
+// maxVarDepth the deepest nesting of var() references an Evaluator resolves,
+// bounding cyclic references
+const maxVarDepth = 32
+
+// maxVarLength the most bytes the var() substitutions of a value may produce, bounding
+// references that grow exponentially, such as "--a: var(--b) var(--b)" chained
+const maxVarLength = 1 << 16
+
+// Evaluator evaluates CSS <color> values against a set of custom properties,
+// resolving their var() references before parsing them like ParseColor.
+type Evaluator struct {
+	// Spec the specification color names are looked up in
+	Spec string
+	// Vars the values of the custom properties, keyed by their name including the leading "--"
+	Vars map[string]string
+}
+
+// NewEvaluator Build an Evaluator from a specification and the values of custom properties, which may be nil
+func NewEvaluator(spec string, vars map[string]string) *Evaluator {
+	return &Evaluator{Spec: spec, Vars: vars}
+}
+
+// Evaluate Evaluate a CSS <color> value such as "rgb(from var(--brand) r g calc(b * 0.5))";
+// syntax errors are reported as a *SyntaxError on the value once its var() references are resolved
+func (e *Evaluator) Evaluate(s string) (Color, error) {
+	r := &varResolver{vars: e.Vars, resolved: make(map[string]string)}
+	resolved, err := r.resolveVars(s, 0)
+	if err != nil {
+		return Color{}, err
+	}
+	return ParseColor(resolved, e.Spec)
+}
+
+// varResolver the state of the substitution of the var() references of a value: the
+// custom properties resolved so far, and the number of bytes substituted
+type varResolver struct {
+	vars     map[string]string
+	resolved map[string]string
+	length   int
+}
+
+// resolveVars Internal helper substituting the var() references of a value, using the
+// fallback of a reference when its custom property is not set
+func (r *varResolver) resolveVars(s string, depth int) (string, error) {
+	if depth > maxVarDepth {
+		return "", &SyntaxError{Input: s, Offset: 0, Msg: "var() references are nested too deeply or cyclic"}
+	}
+	var b strings.Builder
+	i := 0
+	for {
+		start := indexVar(s, i)
+		if start < 0 {
+			b.WriteString(s[i:])
+			return b.String(), nil
+		}
+		b.WriteString(s[i:start])
+		open := start + len("var(")
+		end, comma := matchParen(s, open)
+		if end < 0 {
+			return "", &SyntaxError{Input: s, Offset: start, Msg: "unterminated var()"}
+		}
+		name := strings.TrimSpace(s[open:end])
+		if comma >= 0 {
+			name = strings.TrimSpace(s[open:comma])
+		}
+		if !strings.HasPrefix(name, "--") {
+			return "", &SyntaxError{Input: s, Offset: start, Msg: "expected a custom property name in var()"}
+		}
+		resolved, ok := r.resolved[name]
+		if !ok {
+			value, set := r.vars[name]
+			if !set && comma < 0 {
+				return "", &SyntaxError{Input: s, Offset: start, Msg: "undefined custom property " + strconv.Quote(name)}
+			}
+			if !set {
+				value = s[comma+1 : end]
+			}
+			var err error
+			if resolved, err = r.resolveVars(value, depth+1); err != nil {
+				return "", err
+			}
+			if set {
+				// custom properties resolve the same wherever they are referenced
+				r.resolved[name] = resolved
+			}
+		}
+		r.length += len(resolved)
+		if r.length > maxVarLength {
+			return "", &SyntaxError{Input: s, Offset: start, Msg: "var() references substitute more than " + strconv.Itoa(maxVarLength) + " bytes"}
+		}
+		b.WriteString(" " + strings.TrimSpace(resolved) + " ")
+		i = end + 1
+	}
+}
+
+// indexVar Internal helper returning the offset of the next var( function in s[from:], or -1
+func indexVar(s string, from int) int {
+	for i := from; i+len("var(") <= len(s); i++ {
+		if strings.EqualFold(s[i:i+len("var(")], "var(") && (i == 0 || !isNameChar(s[i-1])) {
+			return i
+		}
+	}
+	return -1
+}
+
+// matchParen Internal helper returning the offset of the parenthesis closing the one opened
+// before s[from:], and the offset of its first top-level comma, -1 when absent
+func matchParen(s string, from int) (int, int) {
+	depth, comma := 0, -1
+	for i := from; i < len(s); i++ {
+		switch s[i] {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return i, comma
+			}
+			depth--
+		case ',':
+			if depth == 0 && comma < 0 {
+				comma = i
+			}
+		}
+	}
+	return -1, comma
+}
@@ -0,0 +1,102 @@
+package webcolors
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseRelativeColor(t *testing.T) {
+	expected := map[string]Hex{
+		"rgb(from #336699 r g calc(b * 0.5))":        "#33664d",
+		"rgb(from red r g b / 50%)":                  "#ff000080",
+		"rgb(from #33669980 r g b)":                  "#33669980",
+		"hsl(from rebeccapurple calc(h + 180) s l)":  "#669933",
+		"hwb(from red h w calc(b + 20))":             "#cc0000",
+		"oklch(from #336699 calc(l + 0.1) c h)":      "#5084b9",
+		"lab(from #336699 l a b / calc(alpha / 2))":  "#33669980",
+		"color(from red display-p3 r g b)":           "#ff0000",
+		"rgb(from rgb(from red b g r) r g b)":        "#0000ff",
+		"rgb(from red calc((r - 55) / 2 + 0.5) 0 0)": "#650000",
+	}
+	for s, hx := range expected {
+		value, err := ParseColor(s, CSS4)
+		if err != nil || value.Hex() != hx {
+			t.Error(s, "expected", hx, "got", value.Hex(), err)
+		}
+	}
+}
+
+func TestParseRelativeColorSpace(t *testing.T) {
+	value, _ := ParseColor("oklch(from #336699 l c h)", CSS3)
+	if value.Space != OKLCHSpace {
+		t.Error("expected oklch, got", value.Space)
+	}
+	value, _ = ParseColor("color(from red xyz x y z)", CSS3)
+	if value.Space != XYZD65 || !closeTo(value.Channels[0], 0.4124, 1e-4) {
+		t.Error("expected xyz-d65 0.4124, got", value)
+	}
+}
+
+func TestParseRelativeColorErrors(t *testing.T) {
+	expected := map[string]int{
+		"rgb(from red calc(r + 10%) g b)":   20,
+		"lch(from red l c calc(h + 30deg))": 24,
+		"rgb(from red calc(r / 0) g b)":     22,
+		"rgb(from red r, g, b)":             14,
+		"rgb(from currentcolor r g b)":      9,
+		"rgb(from red r g x)":               17,
+		"rgb(r g b)":                        4,
+		"rgb(from var(--brand) r g b)":      9,
+	}
+	for s, offset := range expected {
+		_, err := ParseColor(s, CSS3)
+		if e, ok := err.(*SyntaxError); !ok || e.Offset != offset {
+			t.Error(s, "expected a syntax error at offset", offset, "got", err)
+		}
+	}
+}
+
+func TestEvaluator(t *testing.T) {
+	e := NewEvaluator(CSS4, map[string]string{
+		"--brand": "#336699",
+		"--half":  "0.5",
+		"--alias": "var(--brand)",
+		"--loop":  "var(--loop)",
+	})
+	expected := map[string]Hex{
+		"rgb(from var(--brand) r g calc(b * 0.5))":        "#33664d",
+		"rgb(from var(--alias) r g b / var(--half))":      "#33669980",
+		"var(--missing, rebeccapurple)":                   "#663399",
+		"rgb(from red calc(r * var(--half)) g b)":         "#800000",
+		"oklch(from var(--brand) calc(l + 0.1) c h)":      "#5084b9",
+		"hsl(from var(--missing, red) calc(h + 120) s l)": "#00ff00",
+	}
+	for s, hx := range expected {
+		value, err := e.Evaluate(s)
+		if err != nil || value.Hex() != hx {
+			t.Error(s, "expected", hx, "got", value.Hex(), err)
+		}
+	}
+	for _, s := range []string{"var(--missing)", "var(--loop)", "var(brand)", "var(--brand"} {
+		if _, err := e.Evaluate(s); err == nil {
+			t.Error(s, "expected an error")
+		}
+	}
+}
+
+func TestEvaluatorDoublingReferences(t *testing.T) {
+	vars := map[string]string{"--v30": "red"}
+	for i := 0; i < 30; i++ {
+		vars["--v"+strconv.Itoa(i)] = "var(--v" + strconv.Itoa(i+1) + ") var(--v" + strconv.Itoa(i+1) + ")"
+	}
+	e := NewEvaluator(CSS4, vars)
+	var syntaxErr *SyntaxError
+	if _, err := e.Evaluate("var(--v0)"); !errors.As(err, &syntaxErr) {
+		t.Error("expected a *SyntaxError for doubling references, got", err)
+	}
+	value, err := e.Evaluate("var(--v30)")
+	if err != nil || value.Hex() != "#ff0000" {
+		t.Error("expected #ff0000, got", value.Hex(), err)
+	}
+}
@@ -0,0 +1,223 @@
+package webcolors
+
+import (
+	"sort"
+	"strings"
+	"sync"
+)
+
+// # Registry of color name specifications.
+// #################################################################
+//
+// Every lookup by color name or by value resolves against a registered
+// specification. HTML4, CSS2, CSS21, CSS3 and CSS4 are registered when the
+// package is initialized; RegisterSpecification adds others, such as the
+// color names of a brand palette, which may extend one of them.
+//
+// Registered specifications are never modified, so lookups, Names, RangeNames,
+// RangeHexToNames and the Copy functions are safe for concurrent use, even
+// with RegisterSpecification.
+
+// Specification a named set of color names, registered with RegisterSpecification
+type Specification struct {
+	// Name the name lookups refer to the specification by, such as "css3"
+	Name string
+	// NamesToHex mapping of color names to hex colors; names are case-insensitive
+	NamesToHex map[string]string
+	// PreferredNames mapping of hex colors to the name reverse lookups return, for
+	// values defined under several names. Values without a preference get the
+	// one their parent specification returns, or else the first name in alphabetical order.
+	PreferredNames map[string]string
+	// Parent the name of a registered specification whose color names this one
+	// inherits, and may redefine; empty for none
+	Parent string
+	// ColorKeywords the specification recognizes the transparent and currentcolor
+	// keywords, as CSS3 and CSS4 do; inherited from the parent
+	ColorKeywords bool
+}
+
+// registeredSpec a registered specification, with the color names of its parents merged in.
+// It is never modified once registered, so it can be read without locking.
+type registeredSpec struct {
+	namesToHex    map[string]string
+	hexToName     map[string]string
+	colorKeywords bool
+	// names and hexValues the keys of namesToHex and hexToName, sorted
+	names     []string
+	hexValues []string
+	// hexToNames every name of each hex color, in canonical order: the one
+	// of hexToName first, then the others in alphabetical order
+	hexToNames map[string][]string
+
+	indexOnce sync.Once
+	index     []nameIndexEntry
+}
+
+var (
+	specRegistryMu sync.RWMutex
+	specRegistry   = map[string]*registeredSpec{}
+	specNames      []string
+)
+
+// RegisterSpecification Register a specification, making its name usable wherever
+// a specification is expected, such as NameToHex or ParseColor.
+//
+// Names cannot be registered twice, and the parent, if any, must already be registered.
+func RegisterSpecification(spec Specification) error {
+	if spec.Name == "" {
+		return &ColorError{Err: ErrInvalidValue, Expected: "a specification name"}
+	}
+	specRegistryMu.Lock()
+	defer specRegistryMu.Unlock()
+	if _, ok := specRegistry[spec.Name]; ok {
+		return &ColorError{Err: ErrInvalidValue, Value: spec.Name, Spec: spec.Name, Expected: "an unregistered specification name"}
+	}
+	registered := &registeredSpec{
+		namesToHex:    make(map[string]string),
+		hexToName:     make(map[string]string),
+		colorKeywords: spec.ColorKeywords,
+	}
+	var parent *registeredSpec
+	if spec.Parent != "" {
+		var ok bool
+		if parent, ok = specRegistry[spec.Parent]; !ok {
+			return unsupportedSpecError(spec.Parent)
+		}
+		for name, hexValue := range parent.namesToHex {
+			registered.namesToHex[name] = hexValue
+		}
+		registered.colorKeywords = registered.colorKeywords || parent.colorKeywords
+	}
+	for name, value := range spec.NamesToHex {
+		hexValue, err := ParseHex(value)
+		if err != nil {
+			return err
+		}
+		registered.namesToHex[strings.ToLower(name)] = hexValue
+	}
+	for name, hexValue := range registered.namesToHex {
+		if current, ok := registered.hexToName[hexValue]; !ok || name < current {
+			registered.hexToName[hexValue] = name
+		}
+	}
+	if parent != nil {
+		for hexValue, name := range parent.hexToName {
+			if registered.namesToHex[name] == hexValue {
+				registered.hexToName[hexValue] = name
+			}
+		}
+	}
+	for value, name := range spec.PreferredNames {
+		hexValue, err := ParseHex(value)
+		if err != nil {
+			return err
+		}
+		name = strings.ToLower(name)
+		if registered.namesToHex[name] != hexValue {
+			return &ColorError{Err: ErrUnknownName, Value: name, Spec: spec.Name}
+		}
+		registered.hexToName[hexValue] = name
+	}
+	for name := range registered.namesToHex {
+		registered.names = append(registered.names, name)
+	}
+	sort.Strings(registered.names)
+	for hexValue := range registered.hexToName {
+		registered.hexValues = append(registered.hexValues, hexValue)
+	}
+	sort.Strings(registered.hexValues)
+	registered.hexToNames = make(map[string][]string, len(registered.hexValues))
+	for _, hexValue := range registered.hexValues {
+		registered.hexToNames[hexValue] = []string{registered.hexToName[hexValue]}
+	}
+	for _, name := range registered.names {
+		hexValue := registered.namesToHex[name]
+		if name != registered.hexToName[hexValue] {
+			registered.hexToNames[hexValue] = append(registered.hexToNames[hexValue], name)
+		}
+	}
+	specRegistry[spec.Name] = registered
+	specNames = append(specNames, spec.Name)
+	return nil
+}
+
+// Specifications Return the names of the registered specifications, in registration order
+func Specifications() []string {
+	specRegistryMu.RLock()
+	defer specRegistryMu.RUnlock()
+	return append([]string{}, specNames...)
+}
+
+// lookupSpec Internal helper returning a registered specification
+func lookupSpec(spec string) (*registeredSpec, bool) {
+	specRegistryMu.RLock()
+	defer specRegistryMu.RUnlock()
+	registered, ok := specRegistry[spec]
+	return registered, ok
+}
+
+// Names Return the color names of a specification, in alphabetical order
+func Names(spec string) ([]string, error) {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return nil, unsupportedSpecError(spec)
+	}
+	return append([]string{}, registered.names...), nil
+}
+
+// RangeNames Call fn for each color name of a specification and its hex color,
+// in alphabetical order of names, stopping when fn returns false
+func RangeNames(spec string, fn func(name, hexValue string) bool) error {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return unsupportedSpecError(spec)
+	}
+	for _, name := range registered.names {
+		if !fn(name, registered.namesToHex[name]) {
+			break
+		}
+	}
+	return nil
+}
+
+// RangeHexToNames Call fn for each hex color of a specification and the name HexToName
+// returns for it, in order of hex colors, stopping when fn returns false
+func RangeHexToNames(spec string, fn func(hexValue, name string) bool) error {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return unsupportedSpecError(spec)
+	}
+	for _, hexValue := range registered.hexValues {
+		if !fn(hexValue, registered.hexToName[hexValue]) {
+			break
+		}
+	}
+	return nil
+}
+
+// CopyNamesToHex Return a copy of the mapping of color names to hex colors of a specification
+func CopyNamesToHex(spec string) (map[string]string, error) {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return nil, unsupportedSpecError(spec)
+	}
+	return copyTable(registered.namesToHex), nil
+}
+
+// CopyHexToNames Return a copy of the mapping of hex colors to the names HexToName returns for a specification
+func CopyHexToNames(spec string) (map[string]string, error) {
+	registered, ok := lookupSpec(spec)
+	if !ok {
+		return nil, unsupportedSpecError(spec)
+	}
+	return copyTable(registered.hexToName), nil
+}
+
+// copyTable Internal helper copying a color table
+func copyTable(table map[string]string) map[string]string {
+	copied := make(map[string]string, len(table))
+	for k, v := range table {
+		copied[k] = v
+	}
+	return copied
+}
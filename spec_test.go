@@ -0,0 +1,214 @@
+package webcolors
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+var registerBrandOnce sync.Once
+
+// registerBrand registers, once, a brand palette extending CSS4
+func registerBrand(t *testing.T) {
+	registerBrandOnce.Do(func() {
+		err := RegisterSpecification(Specification{
+			Name:   "brand",
+			Parent: CSS4,
+			NamesToHex: map[string]string{
+				"Brand-Blue": "#1E6FD9",
+				"ink":        "#111",
+				"red":        "#e0301e",
+			},
+			PreferredNames: map[string]string{"#1e6fd9": "brand-blue"},
+		})
+		if err != nil {
+			t.Fatal("expected brand to register, got", err)
+		}
+	})
+}
+
+func TestRegisterSpecification(t *testing.T) {
+	registerBrand(t)
+	value, _ := NameToHex("BRAND-BLUE", "brand")
+	if value != "#1e6fd9" {
+		t.Error("expected #1e6fd9, got", value)
+	}
+	value, _ = NameToHex("rebeccapurple", "brand")
+	if value != "#663399" {
+		t.Error("expected #663399, got", value)
+	}
+	value, _ = NameToHex("red", "brand")
+	if value != "#e0301e" {
+		t.Error("expected #e0301e, got", value)
+	}
+	if _, err := HexToName("#ff0000", "brand"); !errors.Is(err, ErrNoNameForValue) {
+		t.Error("expected ErrNoNameForValue for the redefined red, got", err)
+	}
+	value, _ = HexToName("#111111", "brand")
+	if value != "ink" {
+		t.Error("expected ink, got", value)
+	}
+	value, _ = HexToName("#808080", "brand")
+	if value != "gray" {
+		t.Error("expected the inherited gray, got", value)
+	}
+	value, _ = RGBToName([]int{224, 48, 30}, "brand")
+	if value != "red" {
+		t.Error("expected red, got", value)
+	}
+	value, _ = RGBPercentToName([]string{"0%", "0%", "0%"}, "brand")
+	if value != "black" {
+		t.Error("expected black, got", value)
+	}
+	c, err := ParseColor("brand-blue", "brand")
+	if err != nil || c.Hex() != "#1e6fd9" {
+		t.Error("expected #1e6fd9, got", c.Hex(), err)
+	}
+	if c, err = ParseColor("transparent", "brand"); err != nil || c.Hex() != "#00000000" {
+		t.Error("expected the inherited transparent keyword, got", c.Hex(), err)
+	}
+	name, _, _ := HexToNearestName("#1f70d8", "brand", Metric{})
+	if name != "brand-blue" {
+		t.Error("expected brand-blue, got", name)
+	}
+	found := false
+	for _, spec := range Specifications() {
+		found = found || spec == "brand"
+	}
+	if !found {
+		t.Error("expected brand among", Specifications())
+	}
+}
+
+func TestRegisterSpecificationErrors(t *testing.T) {
+	registerBrand(t)
+	if err := RegisterSpecification(Specification{Name: "brand"}); err == nil {
+		t.Error("expected an error registering brand twice")
+	}
+	if err := RegisterSpecification(Specification{Name: "bad-parent", Parent: "css5"}); !errors.Is(err, ErrUnsupportedSpec) {
+		t.Error("expected ErrUnsupportedSpec for an unknown parent, got", err)
+	}
+	err := RegisterSpecification(Specification{Name: "bad-hex", NamesToHex: map[string]string{"mud": "brown"}})
+	if !errors.Is(err, ErrInvalidHex) {
+		t.Error("expected ErrInvalidHex for an invalid value, got", err)
+	}
+	err = RegisterSpecification(Specification{
+		Name:           "bad-preference",
+		NamesToHex:     map[string]string{"mud": "#70543e"},
+		PreferredNames: map[string]string{"#70543e": "dirt"},
+	})
+	if !errors.Is(err, ErrUnknownName) {
+		t.Error("expected ErrUnknownName for an undefined preferred name, got", err)
+	}
+	if _, err := NameToHex("mud", "bad-preference"); !errors.Is(err, ErrUnsupportedSpec) {
+		t.Error("expected failed registrations to be discarded, got", err)
+	}
+	if err := RegisterSpecification(Specification{}); err == nil {
+		t.Error("expected an error for a specification without a name")
+	}
+}
+
+func TestBuiltinSpecifications(t *testing.T) {
+	value, _ := HexToName("#ffa500", CSS21)
+	if value != "orange" {
+		t.Error("expected orange, got", value)
+	}
+	if _, err := NameToHex("orange", CSS2); !errors.Is(err, ErrUnknownName) {
+		t.Error("expected ErrUnknownName for orange in css2, got", err)
+	}
+	value, _ = HexToName("#00ffff", CSS3)
+	if value != "aqua" {
+		t.Error("expected aqua, got", value)
+	}
+	value, _ = HexToName("#2f4f4f", CSS3)
+	if value != "darkslategray" {
+		t.Error("expected darkslategray, got", value)
+	}
+}
+
+func TestDeprecatedViews(t *testing.T) {
+	CSS2NamesToHex["octarine"] = "#7f00ff"
+	defer delete(CSS2NamesToHex, "octarine")
+	if _, ok := HTML4NamesToHex["octarine"]; ok {
+		t.Error("expected CSS2NamesToHex not to alias HTML4NamesToHex")
+	}
+	if _, err := NameToHex("octarine", CSS2); !errors.Is(err, ErrUnknownName) {
+		t.Error("expected lookups to ignore the deprecated views, got", err)
+	}
+	saved := CSS3HexToNames["#ff0000"]
+	CSS3HexToNames["#ff0000"] = "crimson"
+	defer func() { CSS3HexToNames["#ff0000"] = saved }()
+	value, _ := HexToName("#ff0000", CSS3)
+	if value != "red" {
+		t.Error("expected red, got", value)
+	}
+	if len(CSS4NamesToHex) != len(CSS3NamesToHex)+1 || CSS21HexToNames["#ffa500"] != "orange" {
+		t.Error("expected the deprecated views to be filled")
+	}
+}
+
+func TestReadOnlyAccessors(t *testing.T) {
+	names, err := Names(HTML4)
+	if err != nil || len(names) != 17 || names[0] != "aqua" || names[16] != "yellow" {
+		t.Error("expected the 17 html4 names in alphabetical order, got", names, err)
+	}
+	names[0] = "octarine"
+	if names, _ = Names(HTML4); names[0] != "aqua" {
+		t.Error("expected Names to return a copy, got", names[0])
+	}
+	visited := []string{}
+	err = RangeNames(CSS4, func(name, hexValue string) bool {
+		visited = append(visited, name+"="+hexValue)
+		return len(visited) < 2
+	})
+	if err != nil || len(visited) != 2 || visited[0] != "aliceblue=#f0f8ff" || visited[1] != "antiquewhite=#faebd7" {
+		t.Error("expected aliceblue and antiquewhite, got", visited, err)
+	}
+	last := ""
+	err = RangeHexToNames(CSS3, func(hexValue, name string) bool {
+		if hexValue <= last {
+			t.Error("expected increasing hex values, got", hexValue, "after", last)
+		}
+		last = hexValue
+		if hexValue == "#808080" && name != "gray" {
+			t.Error("expected gray, got", name)
+		}
+		return true
+	})
+	if err != nil || last != "#ffffff" {
+		t.Error("expected to visit every hex value, got", last, err)
+	}
+	table, _ := CopyNamesToHex(CSS3)
+	table["red"] = "#000000"
+	if value, _ := NameToHex("red", CSS3); value != "#ff0000" {
+		t.Error("expected CopyNamesToHex to return a copy, got", value)
+	}
+	reverse, _ := CopyHexToNames(CSS4)
+	if reverse["#663399"] != "rebeccapurple" {
+		t.Error("expected rebeccapurple, got", reverse["#663399"])
+	}
+	if err := RangeNames("css5", func(string, string) bool { return true }); !errors.Is(err, ErrUnsupportedSpec) {
+		t.Error("expected ErrUnsupportedSpec, got", err)
+	}
+}
+
+func TestConcurrentLookups(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if i == 0 {
+				RegisterSpecification(Specification{Name: "concurrent", Parent: CSS3})
+			}
+			for j := 0; j < 100; j++ {
+				if value, _ := NameToHex("navy", CSS3); value != "#000080" {
+					t.Error("expected #000080, got", value)
+				}
+				HexToName("#000080", "concurrent")
+				Specifications()
+			}
+		}(i)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,138 @@
+package webcolors
+
+import (
+	"math"
+	"strconv"
+)
+
+// # Accessible color suggestions.
+// #################################################################
+//
+// SuggestContrast searches the colors sharing the OKLCH hue and chroma of a
+// foreground for the one closest in lightness that reaches a contrast target
+// over a background, trying both lighter and darker colors. Colors outside
+// the sRGB gamut are gamut mapped, lowering their chroma, and every candidate
+// is rounded to its 8-bit hexadecimal value before being checked, so that
+// the suggestion still passes once serialized.
+
+// ContrastTarget the contrast a suggested color must reach over its background
+type ContrastTarget struct {
+	// Ratio the minimum WCAG 2.x contrast ratio, such as ContrastAA
+	Ratio float64
+	// APCA the minimum APCA lightness contrast, of either polarity, used when Ratio is zero
+	APCA float64
+}
+
+// String Describe the target, such as "a contrast ratio of 4.5" or "an APCA contrast of 60"
+func (t ContrastTarget) String() string {
+	if t.Ratio > 0 {
+		return "a contrast ratio of " + strconv.FormatFloat(t.Ratio, 'g', -1, 64)
+	}
+	return "an APCA contrast of " + strconv.FormatFloat(t.APCA, 'g', -1, 64)
+}
+
+// Passes Report whether a foreground color over a background color reaches the target
+func (t ContrastTarget) Passes(fg, bg Color) bool {
+	if t.Ratio > 0 {
+		ratio, err := ContrastRatio(fg, bg)
+		return err == nil && ratio >= t.Ratio
+	}
+	return math.Abs(APCAContrast(fg, bg)) >= t.APCA
+}
+
+// SuggestOptions options controlling SuggestContrast
+type SuggestOptions struct {
+	// Spec when set, the suggestion is snapped to the named color of this specification
+	// closest to it under Metric among those reaching the target
+	Spec string
+	// Metric the metric used to snap to a named color, CIEDE2000 when zero
+	Metric Metric
+}
+
+// suggestSteps the number of halvings of the lightness search
+const suggestSteps = 32
+
+// SuggestContrast Suggest the color closest in OKLCH lightness to a foreground color,
+// keeping its hue, chroma where possible, and alpha, that reaches a contrast target over
+// a background. A foreground that already reaches it is returned unchanged, and a
+// *ColorError wrapping ErrUnreachableContrast is returned when no color of its hue does.
+func SuggestContrast(fg, bg Color, target ContrastTarget, opts SuggestOptions) (Color, error) {
+	if target.Ratio <= 0 && target.APCA <= 0 {
+		return Color{}, &ColorError{Err: ErrInvalidValue, Expected: "a contrast target with a positive ratio or APCA contrast"}
+	}
+	if fg.IsCurrentColor() || bg.IsCurrentColor() {
+		return Color{}, currentColorError()
+	}
+	suggestion := fg
+	if !target.Passes(fg, bg) {
+		origin := fg.convert(OKLCHSpace)
+		var found bool
+		var bestDelta float64
+		for _, end := range []float64{0, 1} {
+			candidate, delta, ok := searchLightness(origin, end, bg, target)
+			if ok && (!found || delta < bestDelta) {
+				suggestion, bestDelta, found = candidate, delta, true
+			}
+		}
+		if !found {
+			return Color{}, &ColorError{Err: ErrUnreachableContrast, Value: string(fg.Hex()), Expected: target.String()}
+		}
+	}
+	if opts.Spec == "" {
+		return suggestion, nil
+	}
+	entry, _, err := nearestEntry(suggestion, opts.Spec, opts.Metric, func(c Color) bool {
+		c.Alpha = fg.Alpha
+		return target.Passes(c, bg)
+	})
+	if err != nil {
+		return Color{}, err
+	}
+	snapped := entry.color
+	snapped.Alpha = fg.Alpha
+	return snapped, nil
+}
+
+// searchLightness Internal helper searching, between the lightness of origin and end, for the
+// color closest to origin that reaches the target, returning it and its lightness difference
+func searchLightness(origin Color, end float64, bg Color, target ContrastTarget) (Color, float64, bool) {
+	at := func(lightness float64) Color {
+		c := origin
+		c.Channels[0] = lightness
+		mapped, _ := c.ToGamut(SRGB, GamutMap)
+		rounded, _ := mapped.Hex().Color()
+		return rounded
+	}
+	if !target.Passes(at(end), bg) {
+		return Color{}, 0, false
+	}
+	// passing colors are assumed to lie between the first passing lightness and end
+	near, far := origin.Channels[0], end
+	for i := 0; i < suggestSteps; i++ {
+		middle := (near + far) / 2
+		if target.Passes(at(middle), bg) {
+			far = middle
+		} else {
+			near = middle
+		}
+	}
+	return at(far), math.Abs(far - origin.Channels[0]), true
+}
+
+// SuggestContrastHex Suggest, like SuggestContrast, a color reaching a contrast target
+// for hexadecimal foreground and background values, returning a normalized hexadecimal value
+func SuggestContrastHex(fgHex, bgHex string, target ContrastTarget, opts SuggestOptions) (string, error) {
+	fg, err := Hex(fgHex).Color()
+	if err != nil {
+		return "", err
+	}
+	bg, err := Hex(bgHex).Color()
+	if err != nil {
+		return "", err
+	}
+	suggestion, err := SuggestContrast(fg, bg, target, opts)
+	if err != nil {
+		return "", err
+	}
+	return string(suggestion.Hex()), nil
+}
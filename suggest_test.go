@@ -0,0 +1,69 @@
+package webcolors
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSuggestContrast(t *testing.T) {
+	expected := map[[2]string]string{
+		{"#777777", "#ffffff"}: "#767676",
+		{"#ff0000", "#ffffff"}: "#ee0000",
+		{"#3366ff", "#000000"}: "#3367ff",
+		{"#336699", "#ffffff"}: "#336699",
+	}
+	for colors, hx := range expected {
+		value, err := SuggestContrastHex(colors[0], colors[1], ContrastTarget{Ratio: ContrastAA}, SuggestOptions{})
+		if err != nil || value != hx {
+			t.Error(colors, "expected", hx, "got", value, err)
+		}
+		if result, _ := CheckContrast(value, colors[1], CSS3); !result.AA {
+			t.Error(colors, "expected", value, "to meet AA, got", result.Ratio)
+		}
+	}
+}
+
+func TestSuggestContrastKeepsHue(t *testing.T) {
+	fg, _ := Hex("#4a90e2").Color()
+	bg, _ := Hex("#ffffff").Color()
+	value, _ := SuggestContrast(fg, bg, ContrastTarget{Ratio: ContrastAAA}, SuggestOptions{})
+	if !MeetsAAA(value, bg, false) {
+		ratio, _ := ContrastRatio(value, bg)
+		t.Error("expected the suggestion to meet AAA, got", ratio)
+	}
+	if hue, origin := value.OKLCH().H, fg.OKLCH().H; !closeTo(hue, origin, 2) {
+		t.Error("expected a hue of about", origin, "got", hue)
+	}
+}
+
+func TestSuggestContrastAPCA(t *testing.T) {
+	fg, _ := Hex("#777777").Color()
+	bg, _ := Hex("#ffffff").Color()
+	target := ContrastTarget{APCA: 75}
+	value, _ := SuggestContrast(fg, bg, target, SuggestOptions{})
+	if !target.Passes(value, bg) || value.Hex() != "#6e6e6e" {
+		t.Error("expected #6e6e6e, got", value.Hex(), APCAContrast(value, bg))
+	}
+}
+
+func TestSuggestContrastSnap(t *testing.T) {
+	value, _ := SuggestContrastHex("#777777", "#ffffff", ContrastTarget{Ratio: ContrastAA}, SuggestOptions{Spec: CSS3})
+	if value != "#696969" {
+		t.Error("expected #696969 (dimgray), got", value)
+	}
+}
+
+func TestSuggestContrastErrors(t *testing.T) {
+	_, err := SuggestContrastHex("#999999", "#888888", ContrastTarget{APCA: 75}, SuggestOptions{})
+	var colorErr *ColorError
+	if !errors.Is(err, ErrUnreachableContrast) || !errors.As(err, &colorErr) || colorErr.Value != "#999999" {
+		t.Error("expected ErrUnreachableContrast for #999999, got", err)
+	}
+	if err.Error() != "no color of the hue of #999999 reaches an APCA contrast of 75" {
+		t.Error("expected the foreground and target in the message, got", err)
+	}
+	_, err = SuggestContrastHex("#999999", "#888888", ContrastTarget{}, SuggestOptions{})
+	if !errors.Is(err, ErrInvalidValue) {
+		t.Error("expected ErrInvalidValue for an empty target, got", err)
+	}
+}
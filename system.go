@@ -0,0 +1,211 @@
+package webcolors
+
+import (
+	"strings"
+)
+
+// # CSS system colors.
+// #################################################################
+//
+// System colors such as Canvas or LinkText are valid CSS colors whose value
+// is chosen by the user agent, usually following the operating system theme
+// and the light or dark color scheme of the page. They are kept apart from
+// the named color tables since they have no fixed value; instead they are
+// resolved against a palette for each color scheme.
+//
+// https://www.w3.org/TR/css-color-4/#css-system-colors
+
+// ColorScheme a CSS color scheme, selecting the palette system colors resolve against
+type ColorScheme string
+
+const (
+	// LightScheme the light color scheme
+	LightScheme ColorScheme = "light"
+	// DarkScheme the dark color scheme
+	DarkScheme ColorScheme = "dark"
+)
+
+// SystemPalette mapping of lowercase system color keywords to hex colors
+type SystemPalette map[string]string
+
+// defaultLightSystemPalette the default palette of the light color scheme,
+// modeled on the defaults of common browsers.
+var defaultLightSystemPalette = SystemPalette{
+	"accentcolor":      "#0075ff",
+	"accentcolortext":  "#ffffff",
+	"activetext":       "#ff0000",
+	"buttonborder":     "#767676",
+	"buttonface":       "#efefef",
+	"buttontext":       "#000000",
+	"canvas":           "#ffffff",
+	"canvastext":       "#000000",
+	"field":            "#ffffff",
+	"fieldtext":        "#000000",
+	"graytext":         "#808080",
+	"highlight":        "#3399ff",
+	"highlighttext":    "#ffffff",
+	"linktext":         "#0000ee",
+	"mark":             "#ffff00",
+	"marktext":         "#000000",
+	"selecteditem":     "#3399ff",
+	"selecteditemtext": "#ffffff",
+	"visitedtext":      "#551a8b",
+}
+
+// defaultDarkSystemPalette the default palette of the dark color scheme,
+// modeled on the defaults of common browsers.
+var defaultDarkSystemPalette = SystemPalette{
+	"accentcolor":      "#3b8eea",
+	"accentcolortext":  "#ffffff",
+	"activetext":       "#ff9e9e",
+	"buttonborder":     "#6b6b6b",
+	"buttonface":       "#6b6b6b",
+	"buttontext":       "#ffffff",
+	"canvas":           "#121212",
+	"canvastext":       "#ffffff",
+	"field":            "#3b3b3b",
+	"fieldtext":        "#ffffff",
+	"graytext":         "#6d6d6d",
+	"highlight":        "#3399ff",
+	"highlighttext":    "#ffffff",
+	"linktext":         "#9e9eff",
+	"mark":             "#ffff00",
+	"marktext":         "#000000",
+	"selecteditem":     "#3399ff",
+	"selecteditemtext": "#ffffff",
+	"visitedtext":      "#d0adf0",
+}
+
+// deprecatedSystemColors mapping of the deprecated CSS 2 system color keywords
+// to the system colors CSS Color Level 4 resolves them to.
+//
+// https://www.w3.org/TR/css-color-4/#deprecated-system-colors
+var deprecatedSystemColors = map[string]string{
+	"activeborder":        "buttonborder",
+	"activecaption":       "canvas",
+	"appworkspace":        "canvas",
+	"background":          "canvas",
+	"buttonhighlight":     "buttonface",
+	"buttonshadow":        "buttonface",
+	"captiontext":         "canvastext",
+	"inactiveborder":      "buttonborder",
+	"inactivecaption":     "canvas",
+	"inactivecaptiontext": "graytext",
+	"infobackground":      "canvas",
+	"infotext":            "canvastext",
+	"menu":                "canvas",
+	"menutext":            "canvastext",
+	"scrollbar":           "canvas",
+	"threeddarkshadow":    "buttonborder",
+	"threedface":          "buttonface",
+	"threedhighlight":     "buttonborder",
+	"threedlightshadow":   "buttonborder",
+	"threedshadow":        "buttonborder",
+	"window":              "canvas",
+	"windowframe":         "buttonborder",
+	"windowtext":          "canvastext",
+}
+
+// CopyDefaultSystemPalette Return a copy of the default palette of a color scheme
+func CopyDefaultSystemPalette(scheme ColorScheme) (SystemPalette, error) {
+	defaults, err := defaultSystemPalette(scheme)
+	if err != nil {
+		return nil, err
+	}
+	return SystemPalette(copyTable(defaults)), nil
+}
+
+// CopyDeprecatedSystemColors Return a copy of the mapping of deprecated system color keywords
+// to the system colors they resolve to
+func CopyDeprecatedSystemColors() map[string]string {
+	return copyTable(deprecatedSystemColors)
+}
+
+// defaultSystemPalette Internal helper returning the default palette of a color scheme
+func defaultSystemPalette(scheme ColorScheme) (SystemPalette, error) {
+	switch scheme {
+	case LightScheme:
+		return defaultLightSystemPalette, nil
+	case DarkScheme:
+		return defaultDarkSystemPalette, nil
+	}
+	return nil, invalidValueError(string(scheme), "a supported color scheme")
+}
+
+// normalizeSystemPalette Internal helper copying a palette with its keywords lowercased
+func normalizeSystemPalette(palette SystemPalette) SystemPalette {
+	normalized := make(SystemPalette, len(palette))
+	for name, hexValue := range palette {
+		normalized[strings.ToLower(name)] = hexValue
+	}
+	return normalized
+}
+
+// SystemColorResolver resolves system color keywords against a light and a dark palette.
+//
+// Keywords missing from a palette fall back to the matching default palette.
+type SystemColorResolver struct {
+	light SystemPalette
+	dark  SystemPalette
+}
+
+// NewSystemColorResolver Build a SystemColorResolver from a light and a dark palette, either of which may be nil.
+// The palettes are copied, and their keywords matched case-insensitively.
+func NewSystemColorResolver(light, dark SystemPalette) *SystemColorResolver {
+	return &SystemColorResolver{light: normalizeSystemPalette(light), dark: normalizeSystemPalette(dark)}
+}
+
+// defaultSystemColorResolver the resolver backing SystemColorToHex
+var defaultSystemColorResolver = NewSystemColorResolver(nil, nil)
+
+// IsSystemColor Report whether a keyword is a system color, deprecated or not
+func IsSystemColor(name string) bool {
+	normalized := strings.ToLower(name)
+	if _, ok := deprecatedSystemColors[normalized]; ok {
+		return true
+	}
+	_, ok := defaultLightSystemPalette[normalized]
+	return ok
+}
+
+// Hex Resolve a system color keyword to a normalized hexadecimal color value in the given color scheme
+func (r *SystemColorResolver) Hex(name string, scheme ColorScheme) (string, error) {
+	normalized := strings.ToLower(name)
+	if replacement, ok := deprecatedSystemColors[normalized]; ok {
+		normalized = replacement
+	}
+	if !IsSystemColor(normalized) {
+		return "", &ColorError{Err: ErrUnknownName, Value: name}
+	}
+	defaults, err := defaultSystemPalette(scheme)
+	if err != nil {
+		return "", err
+	}
+	palette := r.light
+	if scheme == DarkScheme {
+		palette = r.dark
+	}
+	hexValue, ok := palette[normalized]
+	if !ok {
+		hexValue, ok = defaults[normalized]
+	}
+	if !ok {
+		return "", &ColorError{Err: ErrUnknownName, Value: name}
+	}
+	return ParseHex(hexValue)
+}
+
+// Color Resolve a system color keyword to a Color in the given color scheme
+func (r *SystemColorResolver) Color(name string, scheme ColorScheme) (Color, error) {
+	hexValue, err := r.Hex(name, scheme)
+	if err != nil {
+		return Color{}, err
+	}
+	return Hex(hexValue).Color()
+}
+
+// SystemColorToHex Resolve a system color keyword to a normalized hexadecimal color value
+// in the given color scheme, using the default palettes
+func SystemColorToHex(name string, scheme ColorScheme) (string, error) {
+	return defaultSystemColorResolver.Hex(name, scheme)
+}
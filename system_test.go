@@ -0,0 +1,71 @@
+package webcolors
+
+import "testing"
+
+func TestIsSystemColor(t *testing.T) {
+	if !IsSystemColor("CanvasText") || !IsSystemColor("ThreeDFace") {
+		t.Error("expected CanvasText and ThreeDFace to be system colors")
+	}
+	if IsSystemColor("red") {
+		t.Error("expected red not to be a system color")
+	}
+}
+
+func TestSystemColorToHex(t *testing.T) {
+	value, _ := SystemColorToHex("Canvas", LightScheme)
+	if value != "#ffffff" {
+		t.Error("expected #ffffff, got", value)
+	}
+	value, _ = SystemColorToHex("WindowText", DarkScheme)
+	if value != "#ffffff" {
+		t.Error("expected #ffffff, got", value)
+	}
+	if _, err := SystemColorToHex("red", LightScheme); err == nil {
+		t.Error("expected an error for red")
+	}
+}
+
+func TestSystemColorResolver(t *testing.T) {
+	r := NewSystemColorResolver(SystemPalette{"linktext": "#06C"}, nil)
+	value, _ := r.Hex("LinkText", LightScheme)
+	if value != "#0066cc" {
+		t.Error("expected #0066cc, got", value)
+	}
+	value, _ = r.Hex("ThreeDFace", LightScheme)
+	if value != "#efefef" {
+		t.Error("expected #efefef, got", value)
+	}
+	c, _ := r.Color("Highlight", DarkScheme)
+	if c.Hex() != "#3399ff" {
+		t.Error("expected #3399ff, got", c.Hex())
+	}
+}
+
+func TestSystemColorResolverMixedCase(t *testing.T) {
+	r := NewSystemColorResolver(SystemPalette{"LinkText": "#06C"}, SystemPalette{"CANVAS": "#000"})
+	value, _ := r.Hex("linktext", LightScheme)
+	if value != "#0066cc" {
+		t.Error("expected #0066cc, got", value)
+	}
+	value, _ = r.Hex("Canvas", DarkScheme)
+	if value != "#000000" {
+		t.Error("expected #000000, got", value)
+	}
+}
+
+func TestCopyDefaultSystemPalette(t *testing.T) {
+	palette, err := CopyDefaultSystemPalette(LightScheme)
+	if err != nil {
+		t.Fatal("expected no error, got", err)
+	}
+	palette["canvas"] = "#000000"
+	deprecated := CopyDeprecatedSystemColors()
+	deprecated["window"] = "canvastext"
+	value, _ := SystemColorToHex("Window", LightScheme)
+	if value != "#ffffff" {
+		t.Error("expected #ffffff, got", value)
+	}
+	if _, err := CopyDefaultSystemPalette(ColorScheme("sepia")); err == nil {
+		t.Error("expected an error for the sepia color scheme")
+	}
+}